@@ -0,0 +1,6333 @@
+// Package api provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.5.0 DO NOT EDIT.
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/oapi-codegen/runtime"
+	strictnethttp "github.com/oapi-codegen/runtime/strictmiddleware/nethttp"
+)
+
+// Defines values for AuditEntryAction.
+const (
+	AuditMergeOverride  AuditEntryAction = "merge_override"
+	AuditReviewDeclined AuditEntryAction = "review_declined"
+)
+
+// Defines values for ErrorCode.
+const (
+	ErrorCodeAlreadyAssigned     ErrorCode = "ALREADY_ASSIGNED"
+	ErrorCodeConcurrentUpdate    ErrorCode = "CONCURRENT_UPDATE"
+	ErrorCodeIncorrectData       ErrorCode = "INCORRECT_DATA"
+	ErrorCodeInternalServerError ErrorCode = "INTERNAL_SERVER_ERROR"
+	ErrorCodeInvalidTransition   ErrorCode = "INVALID_TRANSITION"
+	ErrorCodeMergeBlocked        ErrorCode = "MERGE_BLOCKED"
+	ErrorCodeNoCandidate         ErrorCode = "NO_CANDIDATE"
+	ErrorCodeNotAssigned         ErrorCode = "NOT_ASSIGNED"
+	ErrorCodeNotFound            ErrorCode = "NOT_FOUND"
+	ErrorCodePRExists            ErrorCode = "PR_EXISTS"
+	ErrorCodePRMerged            ErrorCode = "PR_MERGED"
+	ErrorCodePRNotOpen           ErrorCode = "PR_NOT_OPEN"
+	ErrorCodeRepositoryExists    ErrorCode = "REPOSITORY_EXISTS"
+	ErrorCodeReviewerNotEligible ErrorCode = "REVIEWER_NOT_ELIGIBLE"
+	ErrorCodeTeamExists          ErrorCode = "TEAM_EXISTS"
+	ErrorCodeUnauthorized        ErrorCode = "UNAUTHORIZED"
+	ErrorCodeVersionMismatch     ErrorCode = "VERSION_MISMATCH"
+)
+
+// Defines values for ForgeProvider.
+const (
+	ForgeProviderGitHub ForgeProvider = "github"
+	ForgeProviderGitLab ForgeProvider = "gitlab"
+)
+
+// Defines values for IngestResultStatus.
+const (
+	IngestStatusIgnored   IngestResultStatus = "ignored"
+	IngestStatusProcessed IngestResultStatus = "processed"
+)
+
+// Defines values for PullRequestPriority.
+const (
+	PullRequestPriorityHigh   PullRequestPriority = "HIGH"
+	PullRequestPriorityLow    PullRequestPriority = "LOW"
+	PullRequestPriorityNormal PullRequestPriority = "NORMAL"
+	PullRequestPriorityUrgent PullRequestPriority = "URGENT"
+)
+
+// Defines values for PullRequestSize.
+const (
+	PullRequestSizeL  PullRequestSize = "L"
+	PullRequestSizeM  PullRequestSize = "M"
+	PullRequestSizeS  PullRequestSize = "S"
+	PullRequestSizeXL PullRequestSize = "XL"
+	PullRequestSizeXS PullRequestSize = "XS"
+)
+
+// Defines values for PullRequestStatus.
+const (
+	PullRequestStatusClosed PullRequestStatus = "CLOSED"
+	PullRequestStatusDraft  PullRequestStatus = "DRAFT"
+	PullRequestStatusMerged PullRequestStatus = "MERGED"
+	PullRequestStatusOpen   PullRequestStatus = "OPEN"
+)
+
+// Defines values for ReviewState.
+const (
+	ReviewApproved         ReviewState = "APPROVED"
+	ReviewChangesRequested ReviewState = "CHANGES_REQUESTED"
+	ReviewCommented        ReviewState = "COMMENTED"
+	ReviewPending          ReviewState = "PENDING"
+)
+
+// Defines values for ReviewVerdict.
+const (
+	VerdictApproved         ReviewVerdict = "APPROVED"
+	VerdictChangesRequested ReviewVerdict = "CHANGES_REQUESTED"
+	VerdictCommented        ReviewVerdict = "COMMENTED"
+)
+
+// Defines values for ReviewerAssignmentSource.
+const (
+	AssignmentSourceCodeowners ReviewerAssignmentSource = "codeowners"
+	AssignmentSourceRepository ReviewerAssignmentSource = "repository"
+	AssignmentSourceTeam       ReviewerAssignmentSource = "team"
+)
+
+// Defines values for WebhookEventType.
+const (
+	WebhookEventPRClosed           WebhookEventType = "pr.closed"
+	WebhookEventPRCreated          WebhookEventType = "pr.created"
+	WebhookEventPRMerged           WebhookEventType = "pr.merged"
+	WebhookEventPRReady            WebhookEventType = "pr.ready"
+	WebhookEventPRReopened         WebhookEventType = "pr.reopened"
+	WebhookEventPRUnderstaffed     WebhookEventType = "pr.understaffed"
+	WebhookEventPRUpdated          WebhookEventType = "pr.updated"
+	WebhookEventReviewSubmitted    WebhookEventType = "review.submitted"
+	WebhookEventReviewerAssigned   WebhookEventType = "reviewer.assigned"
+	WebhookEventReviewerReassigned WebhookEventType = "reviewer.reassigned"
+	WebhookEventReviewerRemoved    WebhookEventType = "reviewer.removed"
+)
+
+// Defines values for Weekday.
+const (
+	Friday    Weekday = "fri"
+	Monday    Weekday = "mon"
+	Saturday  Weekday = "sat"
+	Sunday    Weekday = "sun"
+	Thursday  Weekday = "thu"
+	Tuesday   Weekday = "tue"
+	Wednesday Weekday = "wed"
+)
+
+// Defines values for WorkingHoursPolicy.
+const (
+	WorkingHoursPrefer  WorkingHoursPolicy = "prefer"
+	WorkingHoursRequire WorkingHoursPolicy = "require"
+)
+
+// AddReviewerRequest defines model for AddReviewerRequest.
+type AddReviewerRequest struct {
+	PullRequestId string `json:"pull_request_id"`
+	ReviewerId    string `json:"reviewer_id"`
+}
+
+// AuditEntry defines model for AuditEntry.
+type AuditEntry struct {
+	Action    AuditEntryAction `json:"action"`
+	Actor     string           `json:"actor"`
+	CreatedAt time.Time        `json:"created_at"`
+
+	// Details Условия политики, не выполненные в момент merge
+	Details []FieldError `json:"details"`
+	Id      int64        `json:"id"`
+	Reason  string       `json:"reason"`
+}
+
+// AuditEntryAction defines model for AuditEntry.Action.
+type AuditEntryAction string
+
+// CodeOwners defines model for CodeOwners.
+type CodeOwners struct {
+	Content      string    `json:"content"`
+	RepositoryId string    `json:"repository_id"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// CodeOwnersRuleMatch defines model for CodeOwnersRuleMatch.
+type CodeOwnersRuleMatch struct {
+	// Line Номер строки правила в CODEOWNERS
+	Line int `json:"line"`
+
+	// Owner Владелец из правила, через которого найден ревьювер
+	Owner   string `json:"owner"`
+	Pattern string `json:"pattern"`
+}
+
+// CodeOwnersSetRequest defines model for CodeOwnersSetRequest.
+type CodeOwnersSetRequest struct {
+	// Content Содержимое файла CODEOWNERS в формате GitHub
+	Content      string `json:"content"`
+	RepositoryId string `json:"repository_id"`
+}
+
+// CreatePullRequestRequest defines model for CreatePullRequestRequest.
+type CreatePullRequestRequest struct {
+	AuthorId string `json:"author_id"`
+
+	// ChangedFiles Пути изменённых файлов относительно корня репозитория
+	ChangedFiles []string `json:"changed_files,omitempty"`
+	Description  *string  `json:"description,omitempty"`
+
+	// Draft Создать черновик; ревьюверы назначаются, когда PR отмечен готовым (/pullRequest/ready)
+	Draft        *bool `json:"draft,omitempty"`
+	FilesChanged *int  `json:"files_changed,omitempty"`
+
+	// Labels Метки PR; приводятся к нижнему регистру
+	Labels []string `json:"labels,omitempty"`
+
+	// LinesAdded Объём изменений PR; по нему определяется размер (size), от которого правила ревью команды могут зависеть. Не переданные счётчики считаются нулевыми; если не передан ни один, размер неизвестен
+	LinesAdded   *int `json:"lines_added,omitempty"`
+	LinesRemoved *int `json:"lines_removed,omitempty"`
+
+	// Priority Срочность PR; по умолчанию NORMAL
+	Priority        *PullRequestPriority `json:"priority,omitempty"`
+	PullRequestId   string               `json:"pull_request_id"`
+	PullRequestName string               `json:"pull_request_name"`
+
+	// RepositoryId Репозиторий, CODEOWNERS которого учитывается при выборе ревьюверов
+	RepositoryId *string `json:"repository_id,omitempty"`
+
+	// RequiredSkills Навыки, которые должны быть покрыты назначенными ревьюверами
+	RequiredSkills []string `json:"required_skills,omitempty"`
+}
+
+// CreatePullRequestResponse defines model for CreatePullRequestResponse.
+type CreatePullRequestResponse struct {
+	Assignments []ReviewerAssignment `json:"assignments"`
+	Pr          PullRequest          `json:"pr"`
+}
+
+// DeclineReviewRequest defines model for DeclineReviewRequest.
+type DeclineReviewRequest struct {
+	PullRequestId string `json:"pull_request_id"`
+	Reason        string `json:"reason"`
+	ReviewerId    string `json:"reviewer_id"`
+}
+
+// DeclineReviewResponse defines model for DeclineReviewResponse.
+type DeclineReviewResponse struct {
+	Pr PullRequest `json:"pr"`
+
+	// ReplacedBy user_id нового ревьювера; нет, если доступных кандидатов не нашлось
+	ReplacedBy *string `json:"replaced_by,omitempty"`
+}
+
+// Error defines model for Error.
+type Error struct {
+	Code    ErrorCode    `json:"code"`
+	Details []FieldError `json:"details,omitempty"`
+	Message string       `json:"message"`
+}
+
+// ErrorCode defines model for ErrorCode.
+type ErrorCode string
+
+// ErrorResponse defines model for ErrorResponse.
+type ErrorResponse struct {
+	Error Error `json:"error"`
+}
+
+// FieldError defines model for FieldError.
+type FieldError struct {
+	// Field Путь до поля запроса (JSON pointer без ведущего слеша) или имя параметра
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// ForgeAccount defines model for ForgeAccount.
+type ForgeAccount struct {
+	Login    string        `json:"login"`
+	Provider ForgeProvider `json:"provider"`
+	UserId   string        `json:"user_id"`
+}
+
+// ForgeAccountLinkRequest defines model for ForgeAccountLinkRequest.
+type ForgeAccountLinkRequest struct {
+	Login    string        `json:"login"`
+	Provider ForgeProvider `json:"provider"`
+	UserId   string        `json:"user_id"`
+}
+
+// ForgeProvider defines model for ForgeProvider.
+type ForgeProvider string
+
+// ForgeRepository defines model for ForgeRepository.
+type ForgeRepository struct {
+	CreatedAt time.Time     `json:"created_at"`
+	FullName  string        `json:"full_name"`
+	Provider  ForgeProvider `json:"provider"`
+}
+
+// ForgeRepositoryAddRequest defines model for ForgeRepositoryAddRequest.
+type ForgeRepositoryAddRequest struct {
+	// FullName Полное имя репозитория на стороне форжа, например org/service
+	FullName string        `json:"full_name"`
+	Provider ForgeProvider `json:"provider"`
+}
+
+// IngestResult defines model for IngestResult.
+type IngestResult struct {
+	PullRequestId *string `json:"pull_request_id,omitempty"`
+
+	// Reason Причина, по которой событие проигнорировано
+	Reason *string            `json:"reason,omitempty"`
+	Status IngestResultStatus `json:"status"`
+}
+
+// IngestResultStatus defines model for IngestResult.Status.
+type IngestResultStatus string
+
+// MergePolicy Условия merge PR. К PR применяется более строгая из политик команды автора и репозитория; по умолчанию merge не ограничен
+type MergePolicy struct {
+	// BlockOnChangesRequested Запрещать merge, пока кто-то из ревьюверов запрашивает изменения
+	BlockOnChangesRequested *bool `json:"block_on_changes_requested,omitempty"`
+
+	// RequireFullStaff Запрещать merge PR с need_more_reviewers
+	RequireFullStaff *bool `json:"require_full_staff,omitempty"`
+
+	// RequiredApprovals Сколько ревьюверов должны одобрить PR
+	RequiredApprovals *int `json:"required_approvals,omitempty"`
+}
+
+// MergePullRequestRequest defines model for MergePullRequestRequest.
+type MergePullRequestRequest struct {
+	// Actor Кто выполняет override
+	Actor *string `json:"actor,omitempty"`
+
+	// Override Смержить в обход политики; нужен заголовок X-Admin-Token, факт записывается в журнал аудита
+	Override      *bool  `json:"override,omitempty"`
+	PullRequestId string `json:"pull_request_id"`
+
+	// Reason Причина override, обязательна вместе с override
+	Reason *string `json:"reason,omitempty"`
+}
+
+// OutOfOffice defines model for OutOfOffice.
+type OutOfOffice struct {
+	CreatedAt       time.Time `json:"created_at"`
+	EndsAt          time.Time `json:"ends_at"`
+	Id              int64     `json:"id"`
+	Reason          string    `json:"reason"`
+	ReassignReviews bool      `json:"reassign_reviews"`
+
+	// ReassignedAt Когда открытые ревью были переназначены
+	ReassignedAt *time.Time `json:"reassigned_at"`
+	StartsAt     time.Time  `json:"starts_at"`
+	UserId       string     `json:"user_id"`
+}
+
+// OutOfOfficeDeleteRequest defines model for OutOfOfficeDeleteRequest.
+type OutOfOfficeDeleteRequest struct {
+	Id int64 `json:"id"`
+}
+
+// OutOfOfficeListResponse defines model for OutOfOfficeListResponse.
+type OutOfOfficeListResponse struct {
+	Periods []OutOfOffice `json:"periods"`
+	UserId  string        `json:"user_id"`
+}
+
+// OutOfOfficeRequest defines model for OutOfOfficeRequest.
+type OutOfOfficeRequest struct {
+	EndsAt time.Time `json:"ends_at"`
+	Reason *string   `json:"reason,omitempty"`
+
+	// ReassignReviews Передать открытые ревью пользователя другим ревьюверам, когда период начнётся
+	ReassignReviews *bool     `json:"reassign_reviews,omitempty"`
+	StartsAt        time.Time `json:"starts_at"`
+	UserId          string    `json:"user_id"`
+}
+
+// PullRequest defines model for PullRequest.
+type PullRequest struct {
+	// AssignedReviewers user_id назначенных ревьюверов (0..2)
+	AssignedReviewers []string   `json:"assigned_reviewers"`
+	AuthorId          string     `json:"author_id"`
+	ChangedFiles      []string   `json:"changed_files,omitempty"`
+	CreatedAt         *time.Time `json:"createdAt"`
+	Description       *string    `json:"description,omitempty"`
+	FilesChanged      *int       `json:"files_changed"`
+	Labels            []string   `json:"labels,omitempty"`
+	LinesAdded        *int       `json:"lines_added"`
+	LinesRemoved      *int       `json:"lines_removed"`
+	MergedAt          *time.Time `json:"mergedAt"`
+
+	// MissingSkills Требуемые навыки, которые не покрыл ни один назначенный ревьювер
+	MissingSkills []string `json:"missing_skills,omitempty"`
+
+	// NeedMoreReviewers Назначено меньше ревьюверов, чем требуется (все кандидаты недоступны или на пределе лимита)
+	NeedMoreReviewers *bool `json:"need_more_reviewers,omitempty"`
+
+	// Priority Срочность PR; по умолчанию NORMAL
+	Priority        PullRequestPriority `json:"priority"`
+	PullRequestId   string              `json:"pull_request_id"`
+	PullRequestName string              `json:"pull_request_name"`
+	RepositoryId    *string             `json:"repository_id"`
+	RequiredSkills  []string            `json:"required_skills,omitempty"`
+
+	// ReviewDueAt Срок ревью по правилам ревью команды автора; нет, если срок не задан
+	ReviewDueAt *time.Time `json:"review_due_at"`
+
+	// ReviewersCount Сколько ревьюверов нужно PR по правилам ревью команды автора; нет, если действует reviewers_count репозитория
+	ReviewersCount *int `json:"reviewers_count,omitempty"`
+
+	// Reviews Состояние ревью каждого назначенного ревьювера
+	Reviews []Review `json:"reviews,omitempty"`
+
+	// Size Размер PR по объёму изменений; нет, если объём неизвестен
+	Size *PullRequestSize `json:"size"`
+
+	// Status Переходы: DRAFT → OPEN (ready) или CLOSED; OPEN → MERGED или CLOSED; CLOSED → OPEN (reopen). MERGED — конечный статус
+	Status PullRequestStatus `json:"status"`
+
+	// Version Версия PR, она же ETag; передаётся в If-Match при изменении PR
+	Version *int64 `json:"version,omitempty"`
+}
+
+// PullRequestAuditResponse defines model for PullRequestAuditResponse.
+type PullRequestAuditResponse struct {
+	Entries       []AuditEntry `json:"entries"`
+	PullRequestId string       `json:"pull_request_id"`
+}
+
+// PullRequestIdRequest defines model for PullRequestIdRequest.
+type PullRequestIdRequest struct {
+	PullRequestId string `json:"pull_request_id"`
+}
+
+// PullRequestPriority Срочность PR; по умолчанию NORMAL
+type PullRequestPriority string
+
+// PullRequestResponse defines model for PullRequestResponse.
+type PullRequestResponse struct {
+	Pr PullRequest `json:"pr"`
+}
+
+// PullRequestShort defines model for PullRequestShort.
+type PullRequestShort struct {
+	AuthorId string   `json:"author_id"`
+	Labels   []string `json:"labels,omitempty"`
+
+	// Priority Срочность PR; по умолчанию NORMAL
+	Priority        PullRequestPriority `json:"priority"`
+	PullRequestId   string              `json:"pull_request_id"`
+	PullRequestName string              `json:"pull_request_name"`
+	ReviewDueAt     *time.Time          `json:"review_due_at"`
+
+	// ReviewState PENDING — ревьювер назначен, но вердикт ещё не отправил
+	ReviewState *ReviewState `json:"review_state,omitempty"`
+
+	// Status Переходы: DRAFT → OPEN (ready) или CLOSED; OPEN → MERGED или CLOSED; CLOSED → OPEN (reopen). MERGED — конечный статус
+	Status PullRequestStatus `json:"status"`
+
+	// SubmittedAt Когда пользователь отправил последний вердикт
+	SubmittedAt *time.Time `json:"submitted_at"`
+}
+
+// PullRequestSize Размер PR: первый из XS (до 10 строк и 1 файла), S (до 50 строк и 5 файлов), M (до 250 строк и 15 файлов), L (до 1000 строк и 40 файлов), в который укладываются и строки (добавленные плюс удалённые), и файлы; иначе XL
+type PullRequestSize string
+
+// PullRequestStatus Переходы: DRAFT → OPEN (ready) или CLOSED; OPEN → MERGED или CLOSED; CLOSED → OPEN (reopen). MERGED — конечный статус
+type PullRequestStatus string
+
+// ReassignPullRequestRequest defines model for ReassignPullRequestRequest.
+type ReassignPullRequestRequest struct {
+	// NewReviewerId Кому передать ревью; к пользователю применяются те же правила, что в /pullRequest/addReviewer. Без него замена выбирается автоматически
+	NewReviewerId *string `json:"new_reviewer_id,omitempty"`
+	OldReviewerId string  `json:"old_reviewer_id"`
+	PullRequestId string  `json:"pull_request_id"`
+}
+
+// ReassignPullRequestResponse defines model for ReassignPullRequestResponse.
+type ReassignPullRequestResponse struct {
+	Pr PullRequest `json:"pr"`
+
+	// ReplacedBy user_id нового ревьювера
+	ReplacedBy string `json:"replaced_by"`
+}
+
+// RemoveReviewerRequest defines model for RemoveReviewerRequest.
+type RemoveReviewerRequest struct {
+	// Backfill Назначить вместо снятого ревьювера доступного кандидата
+	Backfill      *bool  `json:"backfill,omitempty"`
+	PullRequestId string `json:"pull_request_id"`
+	ReviewerId    string `json:"reviewer_id"`
+}
+
+// RemoveReviewerResponse defines model for RemoveReviewerResponse.
+type RemoveReviewerResponse struct {
+	// BackfilledBy user_id назначенной замены; нет, если backfill не запрошен или кандидатов не нашлось
+	BackfilledBy *string     `json:"backfilled_by,omitempty"`
+	Pr           PullRequest `json:"pr"`
+}
+
+// RepositoriesResponse defines model for RepositoriesResponse.
+type RepositoriesResponse struct {
+	Repositories []Repository `json:"repositories"`
+}
+
+// Repository defines model for Repository.
+type Repository struct {
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// Name Человекочитаемое название; по умолчанию совпадает с repository_id
+	Name string `json:"name"`
+
+	// OwnerTeams Команды-владельцы; их участники входят в пул ревьюверов
+	OwnerTeams   []string `json:"owner_teams"`
+	RepositoryId string   `json:"repository_id"`
+
+	// Reviewers Пользователи, явно добавленные в пул ревьюверов
+	Reviewers []string           `json:"reviewers"`
+	Settings  RepositorySettings `json:"settings"`
+	UpdatedAt *time.Time         `json:"updated_at,omitempty"`
+}
+
+// RepositoryDeleteRequest defines model for RepositoryDeleteRequest.
+type RepositoryDeleteRequest struct {
+	RepositoryId string `json:"repository_id"`
+}
+
+// RepositoryRequest defines model for RepositoryRequest.
+type RepositoryRequest struct {
+	Name         *string             `json:"name,omitempty"`
+	OwnerTeams   []string            `json:"owner_teams,omitempty"`
+	RepositoryId string              `json:"repository_id"`
+	Reviewers    []string            `json:"reviewers,omitempty"`
+	Settings     *RepositorySettings `json:"settings,omitempty"`
+}
+
+// RepositoryResponse defines model for RepositoryResponse.
+type RepositoryResponse struct {
+	Repository Repository `json:"repository"`
+}
+
+// RepositorySettings defines model for RepositorySettings.
+type RepositorySettings struct {
+	// AuthorTeamFallback Добирать ревьюверов из команды автора, если пул репозитория исчерпан
+	AuthorTeamFallback *bool `json:"author_team_fallback,omitempty"`
+
+	// MergePolicy Условия merge PR. К PR применяется более строгая из политик команды автора и репозитория; по умолчанию merge не ограничен
+	MergePolicy *MergePolicy `json:"merge_policy,omitempty"`
+
+	// ReviewersCount Сколько ревьюверов назначать на PR репозитория
+	ReviewersCount *int `json:"reviewers_count,omitempty"`
+}
+
+// Review defines model for Review.
+type Review struct {
+	AssignedAt time.Time `json:"assigned_at"`
+	Comment    string    `json:"comment"`
+	ReviewerId string    `json:"reviewer_id"`
+
+	// State PENDING — ревьювер назначен, но вердикт ещё не отправил
+	State       ReviewState `json:"state"`
+	SubmittedAt *time.Time  `json:"submitted_at"`
+}
+
+// ReviewRule Правило ревью PR с меткой label, приоритетом priority и (или) размером size; не заданное условие означает «любой». Сработавшее правило добавляет PR требуемые навыки, задаёт срок ревью и число ревьюверов
+type ReviewRule struct {
+	Label *string `json:"label,omitempty"`
+
+	// Priority Срочность PR; по умолчанию NORMAL
+	Priority *PullRequestPriority `json:"priority,omitempty"`
+
+	// RequiredSkills Навыки, которые должны быть покрыты ревьюверами PR
+	RequiredSkills []string `json:"required_skills,omitempty"`
+
+	// ReviewersCount Сколько ревьюверов назначить PR вместо reviewers_count репозитория; 0 — не менять. Если сработало несколько правил, берётся наибольшее число
+	ReviewersCount *int `json:"reviewers_count,omitempty"`
+
+	// Size Размер PR: первый из XS (до 10 строк и 1 файла), S (до 50 строк и 5 файлов), M (до 250 строк и 15 файлов), L (до 1000 строк и 40 файлов), в который укладываются и строки (добавленные плюс удалённые), и файлы; иначе XL
+	Size *PullRequestSize `json:"size,omitempty"`
+
+	// SlaMinutes За сколько минут с назначения ревьюверов должно быть выполнено ревью; 0 — без срока. Если сработало несколько правил, берётся самый короткий срок
+	SlaMinutes *int `json:"sla_minutes,omitempty"`
+}
+
+// ReviewState PENDING — ревьювер назначен, но вердикт ещё не отправил
+type ReviewState string
+
+// ReviewVerdict defines model for ReviewVerdict.
+type ReviewVerdict string
+
+// ReviewerAssignment defines model for ReviewerAssignment.
+type ReviewerAssignment struct {
+	Rule *CodeOwnersRuleMatch `json:"rule,omitempty"`
+
+	// Skills Требуемые навыки PR, которыми владеет ревьювер
+	Skills []string `json:"skills,omitempty"`
+
+	// Source codeowners — владелец изменённых путей, repository — из пула ревьюверов репозитория,
+	// team — по политике команды автора
+	Source ReviewerAssignmentSource `json:"source"`
+	UserId string                   `json:"user_id"`
+}
+
+// ReviewerAssignmentSource codeowners — владелец изменённых путей, repository — из пула ревьюверов репозитория,
+// team — по политике команды автора
+type ReviewerAssignmentSource string
+
+// ReviewerCapacity defines model for ReviewerCapacity.
+type ReviewerCapacity struct {
+	// CustomLimit Лимит задан пользователю явно, иначе унаследован от команды
+	CustomLimit bool `json:"custom_limit"`
+	IsActive    bool `json:"is_active"`
+
+	// MaxOpenReviews Действующий лимит открытых ревью
+	MaxOpenReviews int `json:"max_open_reviews"`
+
+	// OpenReviews Открытые PR, где пользователь назначен ревьювером
+	OpenReviews int `json:"open_reviews"`
+
+	// Remaining Сколько ещё ревью можно назначить
+	Remaining int    `json:"remaining"`
+	UserId    string `json:"user_id"`
+	Username  string `json:"username"`
+}
+
+// SetIsActiveRequest defines model for SetIsActiveRequest.
+type SetIsActiveRequest struct {
+	IsActive bool   `json:"is_active"`
+	UserId   string `json:"user_id"`
+}
+
+// SubmitReviewRequest defines model for SubmitReviewRequest.
+type SubmitReviewRequest struct {
+	Comment       *string       `json:"comment,omitempty"`
+	PullRequestId string        `json:"pull_request_id"`
+	ReviewerId    string        `json:"reviewer_id"`
+	Verdict       ReviewVerdict `json:"verdict"`
+}
+
+// Team defines model for Team.
+type Team struct {
+	Members  []TeamMember `json:"members"`
+	TeamName string       `json:"team_name"`
+}
+
+// TeamCapacity defines model for TeamCapacity.
+type TeamCapacity struct {
+	// MaxOpenReviews Лимит открытых ревью по умолчанию для участников команды
+	MaxOpenReviews int                `json:"max_open_reviews"`
+	Members        []ReviewerCapacity `json:"members"`
+	TeamName       string             `json:"team_name"`
+}
+
+// TeamMaxOpenReviewsRequest defines model for TeamMaxOpenReviewsRequest.
+type TeamMaxOpenReviewsRequest struct {
+	MaxOpenReviews int    `json:"max_open_reviews"`
+	TeamName       string `json:"team_name"`
+}
+
+// TeamMember defines model for TeamMember.
+type TeamMember struct {
+	IsActive bool   `json:"is_active"`
+	UserId   string `json:"user_id"`
+	Username string `json:"username"`
+}
+
+// TeamMergePolicy defines model for TeamMergePolicy.
+type TeamMergePolicy struct {
+	// MergePolicy Условия merge PR. К PR применяется более строгая из политик команды автора и репозитория; по умолчанию merge не ограничен
+	MergePolicy MergePolicy `json:"merge_policy"`
+	TeamName    string      `json:"team_name"`
+}
+
+// TeamResponse defines model for TeamResponse.
+type TeamResponse struct {
+	Team Team `json:"team"`
+}
+
+// TeamReviewRules defines model for TeamReviewRules.
+type TeamReviewRules struct {
+	// Rules Заменяют текущие правила команды; применяются при назначении ревьюверов PR авторов команды
+	Rules    []ReviewRule `json:"rules"`
+	TeamName string       `json:"team_name"`
+}
+
+// TeamWorkingHoursPolicy defines model for TeamWorkingHoursPolicy.
+type TeamWorkingHoursPolicy struct {
+	TeamName string `json:"team_name"`
+
+	// WorkingHoursPolicy prefer — сначала назначаются ревьюверы, у которых сейчас рабочее время; require — вне рабочего времени ревьювер не назначается
+	WorkingHoursPolicy WorkingHoursPolicy `json:"working_hours_policy"`
+}
+
+// UpdatePullRequestRequest Изменяемые поля PR; не переданные поля не меняются
+type UpdatePullRequestRequest struct {
+	// AuthorId Новый автор; если он был ревьювером PR, вместо него назначается замена
+	AuthorId    *string `json:"author_id,omitempty"`
+	Description *string `json:"description,omitempty"`
+
+	// Labels Заменяют текущие метки PR; приводятся к нижнему регистру
+	Labels *[]string `json:"labels,omitempty"`
+
+	// Priority Срочность PR; по умолчанию NORMAL
+	Priority        *PullRequestPriority `json:"priority,omitempty"`
+	PullRequestId   string               `json:"pull_request_id"`
+	PullRequestName *string              `json:"pull_request_name,omitempty"`
+}
+
+// User defines model for User.
+type User struct {
+	IsActive bool   `json:"is_active"`
+	TeamName string `json:"team_name"`
+	UserId   string `json:"user_id"`
+	Username string `json:"username"`
+
+	// Version Версия пользователя, она же ETag; передаётся в If-Match при изменении пользователя
+	Version *int64 `json:"version,omitempty"`
+}
+
+// UserMaxOpenReviewsRequest defines model for UserMaxOpenReviewsRequest.
+type UserMaxOpenReviewsRequest struct {
+	// MaxOpenReviews null — использовать лимит команды
+	MaxOpenReviews *int   `json:"max_open_reviews"`
+	UserId         string `json:"user_id"`
+}
+
+// UserResponse defines model for UserResponse.
+type UserResponse struct {
+	User User `json:"user"`
+}
+
+// UserReviewsResponse defines model for UserReviewsResponse.
+type UserReviewsResponse struct {
+	PullRequests []PullRequestShort `json:"pull_requests"`
+	UserId       string             `json:"user_id"`
+}
+
+// UserSkills defines model for UserSkills.
+type UserSkills struct {
+	// Skills Навыки в нижнем регистре, например go, sql, frontend, security
+	Skills []string `json:"skills"`
+	UserId string   `json:"user_id"`
+}
+
+// WebhookDeliveriesResponse defines model for WebhookDeliveriesResponse.
+type WebhookDeliveriesResponse struct {
+	Deliveries     []WebhookDelivery `json:"deliveries"`
+	SubscriptionId string            `json:"subscription_id"`
+}
+
+// WebhookDelivery defines model for WebhookDelivery.
+type WebhookDelivery struct {
+	Attempt        int              `json:"attempt"`
+	CreatedAt      time.Time        `json:"created_at"`
+	DeliveryId     int64            `json:"delivery_id"`
+	Error          *string          `json:"error"`
+	EventId        string           `json:"event_id"`
+	EventType      WebhookEventType `json:"event_type"`
+	StatusCode     *int             `json:"status_code"`
+	SubscriptionId string           `json:"subscription_id"`
+	Success        bool             `json:"success"`
+}
+
+// WebhookEventType defines model for WebhookEventType.
+type WebhookEventType string
+
+// WebhookSubscribeRequest defines model for WebhookSubscribeRequest.
+type WebhookSubscribeRequest struct {
+	Events []WebhookEventType `json:"events"`
+
+	// Secret Секрет для подписи HMAC-SHA256; если не передан, генерируется сервисом
+	Secret *string `json:"secret,omitempty"`
+	Url    string  `json:"url"`
+}
+
+// WebhookSubscription defines model for WebhookSubscription.
+type WebhookSubscription struct {
+	CreatedAt time.Time          `json:"created_at"`
+	Events    []WebhookEventType `json:"events"`
+
+	// Secret Возвращается только при создании подписки
+	Secret         *string `json:"secret,omitempty"`
+	SubscriptionId string  `json:"subscription_id"`
+	Url            string  `json:"url"`
+}
+
+// WebhookSubscriptionResponse defines model for WebhookSubscriptionResponse.
+type WebhookSubscriptionResponse struct {
+	Subscription WebhookSubscription `json:"subscription"`
+}
+
+// WebhookUnsubscribeRequest defines model for WebhookUnsubscribeRequest.
+type WebhookUnsubscribeRequest struct {
+	SubscriptionId string `json:"subscription_id"`
+}
+
+// Weekday defines model for Weekday.
+type Weekday string
+
+// WorkingHours defines model for WorkingHours.
+type WorkingHours struct {
+	Days     []Weekday `json:"days"`
+	End      string    `json:"end"`
+	Start    string    `json:"start"`
+	TimeZone string    `json:"time_zone"`
+	UserId   string    `json:"user_id"`
+
+	// WorkingHoursPolicy prefer — сначала назначаются ревьюверы, у которых сейчас рабочее время; require — вне рабочего времени ревьювер не назначается
+	WorkingHoursPolicy WorkingHoursPolicy `json:"working_hours_policy"`
+}
+
+// WorkingHoursDeleteRequest defines model for WorkingHoursDeleteRequest.
+type WorkingHoursDeleteRequest struct {
+	UserId string `json:"user_id"`
+}
+
+// WorkingHoursPolicy prefer — сначала назначаются ревьюверы, у которых сейчас рабочее время; require — вне рабочего времени ревьювер не назначается
+type WorkingHoursPolicy string
+
+// WorkingHoursRequest defines model for WorkingHoursRequest.
+type WorkingHoursRequest struct {
+	Days []Weekday `json:"days"`
+
+	// End Конец рабочего дня; если он раньше start, смена переходит через полночь
+	End string `json:"end"`
+
+	// Start Время суток HH:MM в часовом поясе пользователя
+	Start string `json:"start"`
+
+	// TimeZone Часовой пояс IANA, например Europe/Berlin
+	TimeZone string `json:"time_zone"`
+	UserId   string `json:"user_id"`
+}
+
+// IfMatch defines model for IfMatch.
+type IfMatch = string
+
+// LimitQuery defines model for LimitQuery.
+type LimitQuery = int
+
+// RepositoryIdQuery defines model for RepositoryIdQuery.
+type RepositoryIdQuery = string
+
+// SubscriptionIdQuery defines model for SubscriptionIdQuery.
+type SubscriptionIdQuery = string
+
+// TeamNameQuery defines model for TeamNameQuery.
+type TeamNameQuery = string
+
+// UserIdQuery defines model for UserIdQuery.
+type UserIdQuery = string
+
+// BadRequest defines model for BadRequest.
+type BadRequest = ErrorResponse
+
+// PreconditionFailed defines model for PreconditionFailed.
+type PreconditionFailed = ErrorResponse
+
+// AddPullRequestReviewerParams defines parameters for AddPullRequestReviewer.
+type AddPullRequestReviewerParams struct {
+	// IfMatch ETag (версия) ресурса из предыдущего ответа. Изменение применяется, только если ресурс с тех пор не менялся, иначе возвращается 412 VERSION_MISMATCH
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// ClosePullRequestParams defines parameters for ClosePullRequest.
+type ClosePullRequestParams struct {
+	// IfMatch ETag (версия) ресурса из предыдущего ответа. Изменение применяется, только если ресурс с тех пор не менялся, иначе возвращается 412 VERSION_MISMATCH
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// DeclinePullRequestReviewParams defines parameters for DeclinePullRequestReview.
+type DeclinePullRequestReviewParams struct {
+	// IfMatch ETag (версия) ресурса из предыдущего ответа. Изменение применяется, только если ресурс с тех пор не менялся, иначе возвращается 412 VERSION_MISMATCH
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// GetPullRequestAuditParams defines parameters for GetPullRequestAudit.
+type GetPullRequestAuditParams struct {
+	PullRequestId string `form:"pull_request_id" json:"pull_request_id"`
+}
+
+// MergePullRequestParams defines parameters for MergePullRequest.
+type MergePullRequestParams struct {
+	// IfMatch ETag (версия) ресурса из предыдущего ответа. Изменение применяется, только если ресурс с тех пор не менялся, иначе возвращается 412 VERSION_MISMATCH
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+
+	// XAdminToken Токен администратора, нужен для override
+	XAdminToken *string `json:"X-Admin-Token,omitempty"`
+}
+
+// ReadyPullRequestParams defines parameters for ReadyPullRequest.
+type ReadyPullRequestParams struct {
+	// IfMatch ETag (версия) ресурса из предыдущего ответа. Изменение применяется, только если ресурс с тех пор не менялся, иначе возвращается 412 VERSION_MISMATCH
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// ReassignPullRequestParams defines parameters for ReassignPullRequest.
+type ReassignPullRequestParams struct {
+	// IfMatch ETag (версия) ресурса из предыдущего ответа. Изменение применяется, только если ресурс с тех пор не менялся, иначе возвращается 412 VERSION_MISMATCH
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// RemovePullRequestReviewerParams defines parameters for RemovePullRequestReviewer.
+type RemovePullRequestReviewerParams struct {
+	// IfMatch ETag (версия) ресурса из предыдущего ответа. Изменение применяется, только если ресурс с тех пор не менялся, иначе возвращается 412 VERSION_MISMATCH
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// ReopenPullRequestParams defines parameters for ReopenPullRequest.
+type ReopenPullRequestParams struct {
+	// IfMatch ETag (версия) ресурса из предыдущего ответа. Изменение применяется, только если ресурс с тех пор не менялся, иначе возвращается 412 VERSION_MISMATCH
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// SubmitPullRequestReviewParams defines parameters for SubmitPullRequestReview.
+type SubmitPullRequestReviewParams struct {
+	// IfMatch ETag (версия) ресурса из предыдущего ответа. Изменение применяется, только если ресурс с тех пор не менялся, иначе возвращается 412 VERSION_MISMATCH
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// UpdatePullRequestParams defines parameters for UpdatePullRequest.
+type UpdatePullRequestParams struct {
+	// IfMatch ETag (версия) ресурса из предыдущего ответа. Изменение применяется, только если ресурс с тех пор не менялся, иначе возвращается 412 VERSION_MISMATCH
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// GetRepositoryParams defines parameters for GetRepository.
+type GetRepositoryParams struct {
+	// RepositoryId Идентификатор репозитория
+	RepositoryId RepositoryIdQuery `form:"repository_id" json:"repository_id"`
+}
+
+// GetRepositoryCodeownersParams defines parameters for GetRepositoryCodeowners.
+type GetRepositoryCodeownersParams struct {
+	// RepositoryId Идентификатор репозитория
+	RepositoryId RepositoryIdQuery `form:"repository_id" json:"repository_id"`
+}
+
+// GetTeamParams defines parameters for GetTeam.
+type GetTeamParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
+
+// GetTeamCapacityParams defines parameters for GetTeamCapacity.
+type GetTeamCapacityParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
+
+// GetTeamMergePolicyParams defines parameters for GetTeamMergePolicy.
+type GetTeamMergePolicyParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
+
+// GetTeamReviewRulesParams defines parameters for GetTeamReviewRules.
+type GetTeamReviewRulesParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
+
+// GetUserOutOfOfficeParams defines parameters for GetUserOutOfOffice.
+type GetUserOutOfOfficeParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
+}
+
+// GetUserReviewsParams defines parameters for GetUserReviews.
+type GetUserReviewsParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
+
+	// Label Только PR с этой меткой
+	Label *string `form:"label,omitempty" json:"label,omitempty"`
+
+	// Priority Только PR с этим приоритетом
+	Priority *PullRequestPriority `form:"priority,omitempty" json:"priority,omitempty"`
+}
+
+// GetUserSkillsParams defines parameters for GetUserSkills.
+type GetUserSkillsParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
+}
+
+// GetUserWorkingHoursParams defines parameters for GetUserWorkingHours.
+type GetUserWorkingHoursParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
+}
+
+// SetUserIsActiveParams defines parameters for SetUserIsActive.
+type SetUserIsActiveParams struct {
+	// IfMatch ETag (версия) ресурса из предыдущего ответа. Изменение применяется, только если ресурс с тех пор не менялся, иначе возвращается 412 VERSION_MISMATCH
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// SetUserMaxOpenReviewsParams defines parameters for SetUserMaxOpenReviews.
+type SetUserMaxOpenReviewsParams struct {
+	// IfMatch ETag (версия) ресурса из предыдущего ответа. Изменение применяется, только если ресурс с тех пор не менялся, иначе возвращается 412 VERSION_MISMATCH
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// SetUserSkillsParams defines parameters for SetUserSkills.
+type SetUserSkillsParams struct {
+	// IfMatch ETag (версия) ресурса из предыдущего ответа. Изменение применяется, только если ресурс с тех пор не менялся, иначе возвращается 412 VERSION_MISMATCH
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// GetWebhookDeliveriesParams defines parameters for GetWebhookDeliveries.
+type GetWebhookDeliveriesParams struct {
+	// SubscriptionId Идентификатор подписки на вебхуки
+	SubscriptionId SubscriptionIdQuery `form:"subscription_id" json:"subscription_id"`
+
+	// Limit Максимальное количество записей в ответе
+	Limit *LimitQuery `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// LinkForgeAccountJSONRequestBody defines body for LinkForgeAccount for application/json ContentType.
+type LinkForgeAccountJSONRequestBody = ForgeAccountLinkRequest
+
+// AddForgeRepositoryJSONRequestBody defines body for AddForgeRepository for application/json ContentType.
+type AddForgeRepositoryJSONRequestBody = ForgeRepositoryAddRequest
+
+// AddPullRequestReviewerJSONRequestBody defines body for AddPullRequestReviewer for application/json ContentType.
+type AddPullRequestReviewerJSONRequestBody = AddReviewerRequest
+
+// ClosePullRequestJSONRequestBody defines body for ClosePullRequest for application/json ContentType.
+type ClosePullRequestJSONRequestBody = PullRequestIdRequest
+
+// CreatePullRequestJSONRequestBody defines body for CreatePullRequest for application/json ContentType.
+type CreatePullRequestJSONRequestBody = CreatePullRequestRequest
+
+// DeclinePullRequestReviewJSONRequestBody defines body for DeclinePullRequestReview for application/json ContentType.
+type DeclinePullRequestReviewJSONRequestBody = DeclineReviewRequest
+
+// MergePullRequestJSONRequestBody defines body for MergePullRequest for application/json ContentType.
+type MergePullRequestJSONRequestBody = MergePullRequestRequest
+
+// ReadyPullRequestJSONRequestBody defines body for ReadyPullRequest for application/json ContentType.
+type ReadyPullRequestJSONRequestBody = PullRequestIdRequest
+
+// ReassignPullRequestJSONRequestBody defines body for ReassignPullRequest for application/json ContentType.
+type ReassignPullRequestJSONRequestBody = ReassignPullRequestRequest
+
+// RemovePullRequestReviewerJSONRequestBody defines body for RemovePullRequestReviewer for application/json ContentType.
+type RemovePullRequestReviewerJSONRequestBody = RemoveReviewerRequest
+
+// ReopenPullRequestJSONRequestBody defines body for ReopenPullRequest for application/json ContentType.
+type ReopenPullRequestJSONRequestBody = PullRequestIdRequest
+
+// SubmitPullRequestReviewJSONRequestBody defines body for SubmitPullRequestReview for application/json ContentType.
+type SubmitPullRequestReviewJSONRequestBody = SubmitReviewRequest
+
+// UpdatePullRequestJSONRequestBody defines body for UpdatePullRequest for application/json ContentType.
+type UpdatePullRequestJSONRequestBody = UpdatePullRequestRequest
+
+// AddRepositoryJSONRequestBody defines body for AddRepository for application/json ContentType.
+type AddRepositoryJSONRequestBody = RepositoryRequest
+
+// DeleteRepositoryJSONRequestBody defines body for DeleteRepository for application/json ContentType.
+type DeleteRepositoryJSONRequestBody = RepositoryDeleteRequest
+
+// SetRepositoryCodeownersJSONRequestBody defines body for SetRepositoryCodeowners for application/json ContentType.
+type SetRepositoryCodeownersJSONRequestBody = CodeOwnersSetRequest
+
+// UpdateRepositoryJSONRequestBody defines body for UpdateRepository for application/json ContentType.
+type UpdateRepositoryJSONRequestBody = RepositoryRequest
+
+// AddTeamJSONRequestBody defines body for AddTeam for application/json ContentType.
+type AddTeamJSONRequestBody = Team
+
+// SetTeamMaxOpenReviewsJSONRequestBody defines body for SetTeamMaxOpenReviews for application/json ContentType.
+type SetTeamMaxOpenReviewsJSONRequestBody = TeamMaxOpenReviewsRequest
+
+// SetTeamMergePolicyJSONRequestBody defines body for SetTeamMergePolicy for application/json ContentType.
+type SetTeamMergePolicyJSONRequestBody = TeamMergePolicy
+
+// SetTeamReviewRulesJSONRequestBody defines body for SetTeamReviewRules for application/json ContentType.
+type SetTeamReviewRulesJSONRequestBody = TeamReviewRules
+
+// SetTeamWorkingHoursPolicyJSONRequestBody defines body for SetTeamWorkingHoursPolicy for application/json ContentType.
+type SetTeamWorkingHoursPolicyJSONRequestBody = TeamWorkingHoursPolicy
+
+// AddUserOutOfOfficeJSONRequestBody defines body for AddUserOutOfOffice for application/json ContentType.
+type AddUserOutOfOfficeJSONRequestBody = OutOfOfficeRequest
+
+// DeleteUserOutOfOfficeJSONRequestBody defines body for DeleteUserOutOfOffice for application/json ContentType.
+type DeleteUserOutOfOfficeJSONRequestBody = OutOfOfficeDeleteRequest
+
+// DeleteUserWorkingHoursJSONRequestBody defines body for DeleteUserWorkingHours for application/json ContentType.
+type DeleteUserWorkingHoursJSONRequestBody = WorkingHoursDeleteRequest
+
+// SetUserIsActiveJSONRequestBody defines body for SetUserIsActive for application/json ContentType.
+type SetUserIsActiveJSONRequestBody = SetIsActiveRequest
+
+// SetUserMaxOpenReviewsJSONRequestBody defines body for SetUserMaxOpenReviews for application/json ContentType.
+type SetUserMaxOpenReviewsJSONRequestBody = UserMaxOpenReviewsRequest
+
+// SetUserSkillsJSONRequestBody defines body for SetUserSkills for application/json ContentType.
+type SetUserSkillsJSONRequestBody = UserSkills
+
+// SetUserWorkingHoursJSONRequestBody defines body for SetUserWorkingHours for application/json ContentType.
+type SetUserWorkingHoursJSONRequestBody = WorkingHoursRequest
+
+// SubscribeWebhookJSONRequestBody defines body for SubscribeWebhook for application/json ContentType.
+type SubscribeWebhookJSONRequestBody = WebhookSubscribeRequest
+
+// UnsubscribeWebhookJSONRequestBody defines body for UnsubscribeWebhook for application/json ContentType.
+type UnsubscribeWebhookJSONRequestBody = WebhookUnsubscribeRequest
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Связать логин на GitHub/GitLab с пользователем сервиса
+	// (POST /integrations/accounts/link)
+	LinkForgeAccount(w http.ResponseWriter, r *http.Request)
+	// Разрешить приём событий из репозитория; события остальных репозиториев игнорируются
+	// (POST /integrations/repositories/add)
+	AddForgeRepository(w http.ResponseWriter, r *http.Request)
+	// Вручную назначить ревьювера открытому PR
+	// (POST /pullRequest/addReviewer)
+	AddPullRequestReviewer(w http.ResponseWriter, r *http.Request, params AddPullRequestReviewerParams)
+	// Закрыть PR без merge
+	// (POST /pullRequest/close)
+	ClosePullRequest(w http.ResponseWriter, r *http.Request, params ClosePullRequestParams)
+	// Создать PR и автоматически назначить ревьюверов
+	// (POST /pullRequest/create)
+	CreatePullRequest(w http.ResponseWriter, r *http.Request)
+	// Отказаться от ревью
+	// (POST /pullRequest/decline)
+	DeclinePullRequestReview(w http.ResponseWriter, r *http.Request, params DeclinePullRequestReviewParams)
+	// Журнал аудита PR
+	// (GET /pullRequest/getAudit)
+	GetPullRequestAudit(w http.ResponseWriter, r *http.Request, params GetPullRequestAuditParams)
+	// Пометить PR как MERGED (идемпотентная операция)
+	// (POST /pullRequest/merge)
+	MergePullRequest(w http.ResponseWriter, r *http.Request, params MergePullRequestParams)
+	// Отметить черновик готовым к ревью
+	// (POST /pullRequest/ready)
+	ReadyPullRequest(w http.ResponseWriter, r *http.Request, params ReadyPullRequestParams)
+	// Переназначить конкретного ревьювера на другого из его команды или на указанного пользователя
+	// (POST /pullRequest/reassign)
+	ReassignPullRequest(w http.ResponseWriter, r *http.Request, params ReassignPullRequestParams)
+	// Снять ревьювера с открытого PR
+	// (POST /pullRequest/removeReviewer)
+	RemovePullRequestReviewer(w http.ResponseWriter, r *http.Request, params RemovePullRequestReviewerParams)
+	// Снова открыть закрытый PR
+	// (POST /pullRequest/reopen)
+	ReopenPullRequest(w http.ResponseWriter, r *http.Request, params ReopenPullRequestParams)
+	// Записать вердикт назначенного ревьювера
+	// (POST /pullRequest/review)
+	SubmitPullRequestReview(w http.ResponseWriter, r *http.Request, params SubmitPullRequestReviewParams)
+	// Изменить название, автора, описание и метки PR
+	// (PATCH /pullRequest/update)
+	UpdatePullRequest(w http.ResponseWriter, r *http.Request, params UpdatePullRequestParams)
+	// Создать репозиторий с командами-владельцами и пулом ревьюверов
+	// (POST /repositories/add)
+	AddRepository(w http.ResponseWriter, r *http.Request)
+	// Удалить репозиторий вместе с CODEOWNERS; PR остаются без репозитория
+	// (POST /repositories/delete)
+	DeleteRepository(w http.ResponseWriter, r *http.Request)
+	// Получить репозиторий
+	// (GET /repositories/get)
+	GetRepository(w http.ResponseWriter, r *http.Request, params GetRepositoryParams)
+	// Получить CODEOWNERS репозитория
+	// (GET /repositories/getCodeowners)
+	GetRepositoryCodeowners(w http.ResponseWriter, r *http.Request, params GetRepositoryCodeownersParams)
+	// Список репозиториев
+	// (GET /repositories/list)
+	ListRepositories(w http.ResponseWriter, r *http.Request)
+	// Зарегистрировать (заменить) CODEOWNERS репозитория
+	// (POST /repositories/setCodeowners)
+	SetRepositoryCodeowners(w http.ResponseWriter, r *http.Request)
+	// Заменить название, команды-владельцы, пул ревьюверов и настройки репозитория
+	// (POST /repositories/update)
+	UpdateRepository(w http.ResponseWriter, r *http.Request)
+	// Создать команду с участниками (создаёт/обновляет пользователей)
+	// (POST /team/add)
+	AddTeam(w http.ResponseWriter, r *http.Request)
+	// Получить команду с участниками
+	// (GET /team/get)
+	GetTeam(w http.ResponseWriter, r *http.Request, params GetTeamParams)
+	// Загрузка участников команды и оставшаяся ёмкость для ревью
+	// (GET /team/getCapacity)
+	GetTeamCapacity(w http.ResponseWriter, r *http.Request, params GetTeamCapacityParams)
+	// Получить условия merge команды
+	// (GET /team/getMergePolicy)
+	GetTeamMergePolicy(w http.ResponseWriter, r *http.Request, params GetTeamMergePolicyParams)
+	// Получить правила ревью команды
+	// (GET /team/getReviewRules)
+	GetTeamReviewRules(w http.ResponseWriter, r *http.Request, params GetTeamReviewRulesParams)
+	// Задать лимит открытых ревью по умолчанию для участников команды
+	// (POST /team/setMaxOpenReviews)
+	SetTeamMaxOpenReviews(w http.ResponseWriter, r *http.Request)
+	// Задать условия merge PR, авторы которых состоят в команде
+	// (POST /team/setMergePolicy)
+	SetTeamMergePolicy(w http.ResponseWriter, r *http.Request)
+	// Задать правила ревью PR авторов команды по меткам и приоритету
+	// (POST /team/setReviewRules)
+	SetTeamReviewRules(w http.ResponseWriter, r *http.Request)
+	// Задать, как при назначении ревьюверов учитываются рабочие часы участников команды
+	// (POST /team/setWorkingHoursPolicy)
+	SetTeamWorkingHoursPolicy(w http.ResponseWriter, r *http.Request)
+	// Добавить период отсутствия пользователя
+	// (POST /users/addOutOfOffice)
+	AddUserOutOfOffice(w http.ResponseWriter, r *http.Request)
+	// Удалить период отсутствия
+	// (POST /users/deleteOutOfOffice)
+	DeleteUserOutOfOffice(w http.ResponseWriter, r *http.Request)
+	// Удалить рабочие часы; пользователь снова доступен в любое время
+	// (POST /users/deleteWorkingHours)
+	DeleteUserWorkingHours(w http.ResponseWriter, r *http.Request)
+	// Текущие и будущие периоды отсутствия пользователя
+	// (GET /users/getOutOfOffice)
+	GetUserOutOfOffice(w http.ResponseWriter, r *http.Request, params GetUserOutOfOfficeParams)
+	// Получить PR'ы, где пользователь назначен ревьювером
+	// (GET /users/getReview)
+	GetUserReviews(w http.ResponseWriter, r *http.Request, params GetUserReviewsParams)
+	// Получить навыки пользователя
+	// (GET /users/getSkills)
+	GetUserSkills(w http.ResponseWriter, r *http.Request, params GetUserSkillsParams)
+	// Получить рабочие часы пользователя
+	// (GET /users/getWorkingHours)
+	GetUserWorkingHours(w http.ResponseWriter, r *http.Request, params GetUserWorkingHoursParams)
+	// Установить флаг активности пользователя
+	// (POST /users/setIsActive)
+	SetUserIsActive(w http.ResponseWriter, r *http.Request, params SetUserIsActiveParams)
+	// Задать пользователю собственный лимит открытых ревью
+	// (POST /users/setMaxOpenReviews)
+	SetUserMaxOpenReviews(w http.ResponseWriter, r *http.Request, params SetUserMaxOpenReviewsParams)
+	// Заменить навыки пользователя
+	// (POST /users/setSkills)
+	SetUserSkills(w http.ResponseWriter, r *http.Request, params SetUserSkillsParams)
+	// Задать часовой пояс и рабочие часы пользователя
+	// (POST /users/setWorkingHours)
+	SetUserWorkingHours(w http.ResponseWriter, r *http.Request)
+	// Журнал попыток доставки по подписке (новые сверху)
+	// (GET /webhooks/getDeliveries)
+	GetWebhookDeliveries(w http.ResponseWriter, r *http.Request, params GetWebhookDeliveriesParams)
+	// Подписать URL на события PR. Тело доставки подписывается в заголовке X-Webhook-Signature-256
+	// (POST /webhooks/subscribe)
+	SubscribeWebhook(w http.ResponseWriter, r *http.Request)
+	// Удалить подписку вместе с журналом доставок
+	// (POST /webhooks/unsubscribe)
+	UnsubscribeWebhook(w http.ResponseWriter, r *http.Request)
+}
+
+// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+
+type Unimplemented struct{}
+
+// Связать логин на GitHub/GitLab с пользователем сервиса
+// (POST /integrations/accounts/link)
+func (_ Unimplemented) LinkForgeAccount(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Разрешить приём событий из репозитория; события остальных репозиториев игнорируются
+// (POST /integrations/repositories/add)
+func (_ Unimplemented) AddForgeRepository(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Вручную назначить ревьювера открытому PR
+// (POST /pullRequest/addReviewer)
+func (_ Unimplemented) AddPullRequestReviewer(w http.ResponseWriter, r *http.Request, params AddPullRequestReviewerParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Закрыть PR без merge
+// (POST /pullRequest/close)
+func (_ Unimplemented) ClosePullRequest(w http.ResponseWriter, r *http.Request, params ClosePullRequestParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Создать PR и автоматически назначить ревьюверов
+// (POST /pullRequest/create)
+func (_ Unimplemented) CreatePullRequest(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Отказаться от ревью
+// (POST /pullRequest/decline)
+func (_ Unimplemented) DeclinePullRequestReview(w http.ResponseWriter, r *http.Request, params DeclinePullRequestReviewParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Журнал аудита PR
+// (GET /pullRequest/getAudit)
+func (_ Unimplemented) GetPullRequestAudit(w http.ResponseWriter, r *http.Request, params GetPullRequestAuditParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Пометить PR как MERGED (идемпотентная операция)
+// (POST /pullRequest/merge)
+func (_ Unimplemented) MergePullRequest(w http.ResponseWriter, r *http.Request, params MergePullRequestParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Отметить черновик готовым к ревью
+// (POST /pullRequest/ready)
+func (_ Unimplemented) ReadyPullRequest(w http.ResponseWriter, r *http.Request, params ReadyPullRequestParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Переназначить конкретного ревьювера на другого из его команды или на указанного пользователя
+// (POST /pullRequest/reassign)
+func (_ Unimplemented) ReassignPullRequest(w http.ResponseWriter, r *http.Request, params ReassignPullRequestParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Снять ревьювера с открытого PR
+// (POST /pullRequest/removeReviewer)
+func (_ Unimplemented) RemovePullRequestReviewer(w http.ResponseWriter, r *http.Request, params RemovePullRequestReviewerParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Снова открыть закрытый PR
+// (POST /pullRequest/reopen)
+func (_ Unimplemented) ReopenPullRequest(w http.ResponseWriter, r *http.Request, params ReopenPullRequestParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Записать вердикт назначенного ревьювера
+// (POST /pullRequest/review)
+func (_ Unimplemented) SubmitPullRequestReview(w http.ResponseWriter, r *http.Request, params SubmitPullRequestReviewParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Изменить название, автора, описание и метки PR
+// (PATCH /pullRequest/update)
+func (_ Unimplemented) UpdatePullRequest(w http.ResponseWriter, r *http.Request, params UpdatePullRequestParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Создать репозиторий с командами-владельцами и пулом ревьюверов
+// (POST /repositories/add)
+func (_ Unimplemented) AddRepository(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Удалить репозиторий вместе с CODEOWNERS; PR остаются без репозитория
+// (POST /repositories/delete)
+func (_ Unimplemented) DeleteRepository(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить репозиторий
+// (GET /repositories/get)
+func (_ Unimplemented) GetRepository(w http.ResponseWriter, r *http.Request, params GetRepositoryParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить CODEOWNERS репозитория
+// (GET /repositories/getCodeowners)
+func (_ Unimplemented) GetRepositoryCodeowners(w http.ResponseWriter, r *http.Request, params GetRepositoryCodeownersParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Список репозиториев
+// (GET /repositories/list)
+func (_ Unimplemented) ListRepositories(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Зарегистрировать (заменить) CODEOWNERS репозитория
+// (POST /repositories/setCodeowners)
+func (_ Unimplemented) SetRepositoryCodeowners(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Заменить название, команды-владельцы, пул ревьюверов и настройки репозитория
+// (POST /repositories/update)
+func (_ Unimplemented) UpdateRepository(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Создать команду с участниками (создаёт/обновляет пользователей)
+// (POST /team/add)
+func (_ Unimplemented) AddTeam(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить команду с участниками
+// (GET /team/get)
+func (_ Unimplemented) GetTeam(w http.ResponseWriter, r *http.Request, params GetTeamParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Загрузка участников команды и оставшаяся ёмкость для ревью
+// (GET /team/getCapacity)
+func (_ Unimplemented) GetTeamCapacity(w http.ResponseWriter, r *http.Request, params GetTeamCapacityParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить условия merge команды
+// (GET /team/getMergePolicy)
+func (_ Unimplemented) GetTeamMergePolicy(w http.ResponseWriter, r *http.Request, params GetTeamMergePolicyParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить правила ревью команды
+// (GET /team/getReviewRules)
+func (_ Unimplemented) GetTeamReviewRules(w http.ResponseWriter, r *http.Request, params GetTeamReviewRulesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Задать лимит открытых ревью по умолчанию для участников команды
+// (POST /team/setMaxOpenReviews)
+func (_ Unimplemented) SetTeamMaxOpenReviews(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Задать условия merge PR, авторы которых состоят в команде
+// (POST /team/setMergePolicy)
+func (_ Unimplemented) SetTeamMergePolicy(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Задать правила ревью PR авторов команды по меткам и приоритету
+// (POST /team/setReviewRules)
+func (_ Unimplemented) SetTeamReviewRules(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Задать, как при назначении ревьюверов учитываются рабочие часы участников команды
+// (POST /team/setWorkingHoursPolicy)
+func (_ Unimplemented) SetTeamWorkingHoursPolicy(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Добавить период отсутствия пользователя
+// (POST /users/addOutOfOffice)
+func (_ Unimplemented) AddUserOutOfOffice(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Удалить период отсутствия
+// (POST /users/deleteOutOfOffice)
+func (_ Unimplemented) DeleteUserOutOfOffice(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Удалить рабочие часы; пользователь снова доступен в любое время
+// (POST /users/deleteWorkingHours)
+func (_ Unimplemented) DeleteUserWorkingHours(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Текущие и будущие периоды отсутствия пользователя
+// (GET /users/getOutOfOffice)
+func (_ Unimplemented) GetUserOutOfOffice(w http.ResponseWriter, r *http.Request, params GetUserOutOfOfficeParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить PR'ы, где пользователь назначен ревьювером
+// (GET /users/getReview)
+func (_ Unimplemented) GetUserReviews(w http.ResponseWriter, r *http.Request, params GetUserReviewsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить навыки пользователя
+// (GET /users/getSkills)
+func (_ Unimplemented) GetUserSkills(w http.ResponseWriter, r *http.Request, params GetUserSkillsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить рабочие часы пользователя
+// (GET /users/getWorkingHours)
+func (_ Unimplemented) GetUserWorkingHours(w http.ResponseWriter, r *http.Request, params GetUserWorkingHoursParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Установить флаг активности пользователя
+// (POST /users/setIsActive)
+func (_ Unimplemented) SetUserIsActive(w http.ResponseWriter, r *http.Request, params SetUserIsActiveParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Задать пользователю собственный лимит открытых ревью
+// (POST /users/setMaxOpenReviews)
+func (_ Unimplemented) SetUserMaxOpenReviews(w http.ResponseWriter, r *http.Request, params SetUserMaxOpenReviewsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Заменить навыки пользователя
+// (POST /users/setSkills)
+func (_ Unimplemented) SetUserSkills(w http.ResponseWriter, r *http.Request, params SetUserSkillsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Задать часовой пояс и рабочие часы пользователя
+// (POST /users/setWorkingHours)
+func (_ Unimplemented) SetUserWorkingHours(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Журнал попыток доставки по подписке (новые сверху)
+// (GET /webhooks/getDeliveries)
+func (_ Unimplemented) GetWebhookDeliveries(w http.ResponseWriter, r *http.Request, params GetWebhookDeliveriesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Подписать URL на события PR. Тело доставки подписывается в заголовке X-Webhook-Signature-256
+// (POST /webhooks/subscribe)
+func (_ Unimplemented) SubscribeWebhook(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Удалить подписку вместе с журналом доставок
+// (POST /webhooks/unsubscribe)
+func (_ Unimplemented) UnsubscribeWebhook(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// LinkForgeAccount operation middleware
+func (siw *ServerInterfaceWrapper) LinkForgeAccount(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.LinkForgeAccount(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AddForgeRepository operation middleware
+func (siw *ServerInterfaceWrapper) AddForgeRepository(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddForgeRepository(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AddPullRequestReviewer operation middleware
+func (siw *ServerInterfaceWrapper) AddPullRequestReviewer(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AddPullRequestReviewerParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddPullRequestReviewer(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ClosePullRequest operation middleware
+func (siw *ServerInterfaceWrapper) ClosePullRequest(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ClosePullRequestParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ClosePullRequest(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreatePullRequest operation middleware
+func (siw *ServerInterfaceWrapper) CreatePullRequest(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreatePullRequest(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeclinePullRequestReview operation middleware
+func (siw *ServerInterfaceWrapper) DeclinePullRequestReview(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeclinePullRequestReviewParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeclinePullRequestReview(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPullRequestAudit operation middleware
+func (siw *ServerInterfaceWrapper) GetPullRequestAudit(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPullRequestAuditParams
+
+	// ------------- Required query parameter "pull_request_id" -------------
+
+	if paramValue := r.URL.Query().Get("pull_request_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "pull_request_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "pull_request_id", r.URL.Query(), &params.PullRequestId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pull_request_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPullRequestAudit(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// MergePullRequest operation middleware
+func (siw *ServerInterfaceWrapper) MergePullRequest(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params MergePullRequestParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	// ------------- Optional header parameter "X-Admin-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Admin-Token")]; found {
+		var XAdminToken string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Admin-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Admin-Token", valueList[0], &XAdminToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Admin-Token", Err: err})
+			return
+		}
+
+		params.XAdminToken = &XAdminToken
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.MergePullRequest(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ReadyPullRequest operation middleware
+func (siw *ServerInterfaceWrapper) ReadyPullRequest(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ReadyPullRequestParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReadyPullRequest(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ReassignPullRequest operation middleware
+func (siw *ServerInterfaceWrapper) ReassignPullRequest(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ReassignPullRequestParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReassignPullRequest(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RemovePullRequestReviewer operation middleware
+func (siw *ServerInterfaceWrapper) RemovePullRequestReviewer(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RemovePullRequestReviewerParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RemovePullRequestReviewer(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ReopenPullRequest operation middleware
+func (siw *ServerInterfaceWrapper) ReopenPullRequest(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ReopenPullRequestParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReopenPullRequest(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SubmitPullRequestReview operation middleware
+func (siw *ServerInterfaceWrapper) SubmitPullRequestReview(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SubmitPullRequestReviewParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SubmitPullRequestReview(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdatePullRequest operation middleware
+func (siw *ServerInterfaceWrapper) UpdatePullRequest(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdatePullRequestParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdatePullRequest(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AddRepository operation middleware
+func (siw *ServerInterfaceWrapper) AddRepository(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddRepository(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteRepository operation middleware
+func (siw *ServerInterfaceWrapper) DeleteRepository(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteRepository(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetRepository operation middleware
+func (siw *ServerInterfaceWrapper) GetRepository(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetRepositoryParams
+
+	// ------------- Required query parameter "repository_id" -------------
+
+	if paramValue := r.URL.Query().Get("repository_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "repository_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "repository_id", r.URL.Query(), &params.RepositoryId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repository_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRepository(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetRepositoryCodeowners operation middleware
+func (siw *ServerInterfaceWrapper) GetRepositoryCodeowners(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetRepositoryCodeownersParams
+
+	// ------------- Required query parameter "repository_id" -------------
+
+	if paramValue := r.URL.Query().Get("repository_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "repository_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "repository_id", r.URL.Query(), &params.RepositoryId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repository_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRepositoryCodeowners(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListRepositories operation middleware
+func (siw *ServerInterfaceWrapper) ListRepositories(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListRepositories(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetRepositoryCodeowners operation middleware
+func (siw *ServerInterfaceWrapper) SetRepositoryCodeowners(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetRepositoryCodeowners(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateRepository operation middleware
+func (siw *ServerInterfaceWrapper) UpdateRepository(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateRepository(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AddTeam operation middleware
+func (siw *ServerInterfaceWrapper) AddTeam(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddTeam(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTeam operation middleware
+func (siw *ServerInterfaceWrapper) GetTeam(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTeam(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTeamCapacity operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamCapacity(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamCapacityParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTeamCapacity(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTeamMergePolicy operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamMergePolicy(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamMergePolicyParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTeamMergePolicy(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTeamReviewRules operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamReviewRules(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamReviewRulesParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTeamReviewRules(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetTeamMaxOpenReviews operation middleware
+func (siw *ServerInterfaceWrapper) SetTeamMaxOpenReviews(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetTeamMaxOpenReviews(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetTeamMergePolicy operation middleware
+func (siw *ServerInterfaceWrapper) SetTeamMergePolicy(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetTeamMergePolicy(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetTeamReviewRules operation middleware
+func (siw *ServerInterfaceWrapper) SetTeamReviewRules(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetTeamReviewRules(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetTeamWorkingHoursPolicy operation middleware
+func (siw *ServerInterfaceWrapper) SetTeamWorkingHoursPolicy(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetTeamWorkingHoursPolicy(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AddUserOutOfOffice operation middleware
+func (siw *ServerInterfaceWrapper) AddUserOutOfOffice(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddUserOutOfOffice(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteUserOutOfOffice operation middleware
+func (siw *ServerInterfaceWrapper) DeleteUserOutOfOffice(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteUserOutOfOffice(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteUserWorkingHours operation middleware
+func (siw *ServerInterfaceWrapper) DeleteUserWorkingHours(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteUserWorkingHours(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUserOutOfOffice operation middleware
+func (siw *ServerInterfaceWrapper) GetUserOutOfOffice(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUserOutOfOfficeParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUserOutOfOffice(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUserReviews operation middleware
+func (siw *ServerInterfaceWrapper) GetUserReviews(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUserReviewsParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "label" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "label", r.URL.Query(), &params.Label)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "label", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "priority" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "priority", r.URL.Query(), &params.Priority)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "priority", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUserReviews(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUserSkills operation middleware
+func (siw *ServerInterfaceWrapper) GetUserSkills(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUserSkillsParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUserSkills(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUserWorkingHours operation middleware
+func (siw *ServerInterfaceWrapper) GetUserWorkingHours(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUserWorkingHoursParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUserWorkingHours(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetUserIsActive operation middleware
+func (siw *ServerInterfaceWrapper) SetUserIsActive(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SetUserIsActiveParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetUserIsActive(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetUserMaxOpenReviews operation middleware
+func (siw *ServerInterfaceWrapper) SetUserMaxOpenReviews(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SetUserMaxOpenReviewsParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetUserMaxOpenReviews(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetUserSkills operation middleware
+func (siw *ServerInterfaceWrapper) SetUserSkills(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SetUserSkillsParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetUserSkills(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetUserWorkingHours operation middleware
+func (siw *ServerInterfaceWrapper) SetUserWorkingHours(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetUserWorkingHours(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetWebhookDeliveries operation middleware
+func (siw *ServerInterfaceWrapper) GetWebhookDeliveries(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetWebhookDeliveriesParams
+
+	// ------------- Required query parameter "subscription_id" -------------
+
+	if paramValue := r.URL.Query().Get("subscription_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "subscription_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "subscription_id", r.URL.Query(), &params.SubscriptionId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "subscription_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWebhookDeliveries(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SubscribeWebhook operation middleware
+func (siw *ServerInterfaceWrapper) SubscribeWebhook(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SubscribeWebhook(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UnsubscribeWebhook operation middleware
+func (siw *ServerInterfaceWrapper) UnsubscribeWebhook(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UnsubscribeWebhook(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/integrations/accounts/link", wrapper.LinkForgeAccount)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/integrations/repositories/add", wrapper.AddForgeRepository)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/addReviewer", wrapper.AddPullRequestReviewer)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/close", wrapper.ClosePullRequest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/create", wrapper.CreatePullRequest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/decline", wrapper.DeclinePullRequestReview)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/pullRequest/getAudit", wrapper.GetPullRequestAudit)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/merge", wrapper.MergePullRequest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/ready", wrapper.ReadyPullRequest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/reassign", wrapper.ReassignPullRequest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/removeReviewer", wrapper.RemovePullRequestReviewer)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/reopen", wrapper.ReopenPullRequest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/review", wrapper.SubmitPullRequestReview)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/pullRequest/update", wrapper.UpdatePullRequest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/repositories/add", wrapper.AddRepository)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/repositories/delete", wrapper.DeleteRepository)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/repositories/get", wrapper.GetRepository)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/repositories/getCodeowners", wrapper.GetRepositoryCodeowners)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/repositories/list", wrapper.ListRepositories)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/repositories/setCodeowners", wrapper.SetRepositoryCodeowners)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/repositories/update", wrapper.UpdateRepository)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/add", wrapper.AddTeam)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/team/get", wrapper.GetTeam)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/team/getCapacity", wrapper.GetTeamCapacity)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/team/getMergePolicy", wrapper.GetTeamMergePolicy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/team/getReviewRules", wrapper.GetTeamReviewRules)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/setMaxOpenReviews", wrapper.SetTeamMaxOpenReviews)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/setMergePolicy", wrapper.SetTeamMergePolicy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/setReviewRules", wrapper.SetTeamReviewRules)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/setWorkingHoursPolicy", wrapper.SetTeamWorkingHoursPolicy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/addOutOfOffice", wrapper.AddUserOutOfOffice)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/deleteOutOfOffice", wrapper.DeleteUserOutOfOffice)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/deleteWorkingHours", wrapper.DeleteUserWorkingHours)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/getOutOfOffice", wrapper.GetUserOutOfOffice)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/getReview", wrapper.GetUserReviews)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/getSkills", wrapper.GetUserSkills)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/getWorkingHours", wrapper.GetUserWorkingHours)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/setIsActive", wrapper.SetUserIsActive)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/setMaxOpenReviews", wrapper.SetUserMaxOpenReviews)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/setSkills", wrapper.SetUserSkills)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/setWorkingHours", wrapper.SetUserWorkingHours)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/webhooks/getDeliveries", wrapper.GetWebhookDeliveries)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/webhooks/subscribe", wrapper.SubscribeWebhook)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/webhooks/unsubscribe", wrapper.UnsubscribeWebhook)
+	})
+
+	return r
+}
+
+type BadRequestJSONResponse ErrorResponse
+
+type PreconditionFailedJSONResponse ErrorResponse
+
+type LinkForgeAccountRequestObject struct {
+	Body *LinkForgeAccountJSONRequestBody
+}
+
+type LinkForgeAccountResponseObject interface {
+	VisitLinkForgeAccountResponse(w http.ResponseWriter) error
+}
+
+type LinkForgeAccount201JSONResponse ForgeAccount
+
+func (response LinkForgeAccount201JSONResponse) VisitLinkForgeAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LinkForgeAccount400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response LinkForgeAccount400JSONResponse) VisitLinkForgeAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LinkForgeAccount404JSONResponse ErrorResponse
+
+func (response LinkForgeAccount404JSONResponse) VisitLinkForgeAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddForgeRepositoryRequestObject struct {
+	Body *AddForgeRepositoryJSONRequestBody
+}
+
+type AddForgeRepositoryResponseObject interface {
+	VisitAddForgeRepositoryResponse(w http.ResponseWriter) error
+}
+
+type AddForgeRepository201JSONResponse ForgeRepository
+
+func (response AddForgeRepository201JSONResponse) VisitAddForgeRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddForgeRepository400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response AddForgeRepository400JSONResponse) VisitAddForgeRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddPullRequestReviewerRequestObject struct {
+	Params AddPullRequestReviewerParams
+	Body   *AddPullRequestReviewerJSONRequestBody
+}
+
+type AddPullRequestReviewerResponseObject interface {
+	VisitAddPullRequestReviewerResponse(w http.ResponseWriter) error
+}
+
+type AddPullRequestReviewer200ResponseHeaders struct {
+	ETag string
+}
+
+type AddPullRequestReviewer200JSONResponse struct {
+	Body    PullRequestResponse
+	Headers AddPullRequestReviewer200ResponseHeaders
+}
+
+func (response AddPullRequestReviewer200JSONResponse) VisitAddPullRequestReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type AddPullRequestReviewer400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response AddPullRequestReviewer400JSONResponse) VisitAddPullRequestReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddPullRequestReviewer404JSONResponse ErrorResponse
+
+func (response AddPullRequestReviewer404JSONResponse) VisitAddPullRequestReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddPullRequestReviewer409JSONResponse ErrorResponse
+
+func (response AddPullRequestReviewer409JSONResponse) VisitAddPullRequestReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddPullRequestReviewer412JSONResponse struct{ PreconditionFailedJSONResponse }
+
+func (response AddPullRequestReviewer412JSONResponse) VisitAddPullRequestReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ClosePullRequestRequestObject struct {
+	Params ClosePullRequestParams
+	Body   *ClosePullRequestJSONRequestBody
+}
+
+type ClosePullRequestResponseObject interface {
+	VisitClosePullRequestResponse(w http.ResponseWriter) error
+}
+
+type ClosePullRequest200ResponseHeaders struct {
+	ETag string
+}
+
+type ClosePullRequest200JSONResponse struct {
+	Body    PullRequestResponse
+	Headers ClosePullRequest200ResponseHeaders
+}
+
+func (response ClosePullRequest200JSONResponse) VisitClosePullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ClosePullRequest400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ClosePullRequest400JSONResponse) VisitClosePullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ClosePullRequest404JSONResponse ErrorResponse
+
+func (response ClosePullRequest404JSONResponse) VisitClosePullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ClosePullRequest409JSONResponse ErrorResponse
+
+func (response ClosePullRequest409JSONResponse) VisitClosePullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ClosePullRequest412JSONResponse struct{ PreconditionFailedJSONResponse }
+
+func (response ClosePullRequest412JSONResponse) VisitClosePullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePullRequestRequestObject struct {
+	Body *CreatePullRequestJSONRequestBody
+}
+
+type CreatePullRequestResponseObject interface {
+	VisitCreatePullRequestResponse(w http.ResponseWriter) error
+}
+
+type CreatePullRequest201JSONResponse CreatePullRequestResponse
+
+func (response CreatePullRequest201JSONResponse) VisitCreatePullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePullRequest400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreatePullRequest400JSONResponse) VisitCreatePullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePullRequest404JSONResponse ErrorResponse
+
+func (response CreatePullRequest404JSONResponse) VisitCreatePullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePullRequest409JSONResponse ErrorResponse
+
+func (response CreatePullRequest409JSONResponse) VisitCreatePullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeclinePullRequestReviewRequestObject struct {
+	Params DeclinePullRequestReviewParams
+	Body   *DeclinePullRequestReviewJSONRequestBody
+}
+
+type DeclinePullRequestReviewResponseObject interface {
+	VisitDeclinePullRequestReviewResponse(w http.ResponseWriter) error
+}
+
+type DeclinePullRequestReview200ResponseHeaders struct {
+	ETag string
+}
+
+type DeclinePullRequestReview200JSONResponse struct {
+	Body    DeclineReviewResponse
+	Headers DeclinePullRequestReview200ResponseHeaders
+}
+
+func (response DeclinePullRequestReview200JSONResponse) VisitDeclinePullRequestReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DeclinePullRequestReview400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response DeclinePullRequestReview400JSONResponse) VisitDeclinePullRequestReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeclinePullRequestReview404JSONResponse ErrorResponse
+
+func (response DeclinePullRequestReview404JSONResponse) VisitDeclinePullRequestReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeclinePullRequestReview409JSONResponse ErrorResponse
+
+func (response DeclinePullRequestReview409JSONResponse) VisitDeclinePullRequestReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeclinePullRequestReview412JSONResponse struct{ PreconditionFailedJSONResponse }
+
+func (response DeclinePullRequestReview412JSONResponse) VisitDeclinePullRequestReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestAuditRequestObject struct {
+	Params GetPullRequestAuditParams
+}
+
+type GetPullRequestAuditResponseObject interface {
+	VisitGetPullRequestAuditResponse(w http.ResponseWriter) error
+}
+
+type GetPullRequestAudit200JSONResponse PullRequestAuditResponse
+
+func (response GetPullRequestAudit200JSONResponse) VisitGetPullRequestAuditResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestAudit400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetPullRequestAudit400JSONResponse) VisitGetPullRequestAuditResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestAudit404JSONResponse ErrorResponse
+
+func (response GetPullRequestAudit404JSONResponse) VisitGetPullRequestAuditResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MergePullRequestRequestObject struct {
+	Params MergePullRequestParams
+	Body   *MergePullRequestJSONRequestBody
+}
+
+type MergePullRequestResponseObject interface {
+	VisitMergePullRequestResponse(w http.ResponseWriter) error
+}
+
+type MergePullRequest200ResponseHeaders struct {
+	ETag string
+}
+
+type MergePullRequest200JSONResponse struct {
+	Body    PullRequestResponse
+	Headers MergePullRequest200ResponseHeaders
+}
+
+func (response MergePullRequest200JSONResponse) VisitMergePullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type MergePullRequest400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response MergePullRequest400JSONResponse) VisitMergePullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MergePullRequest401JSONResponse ErrorResponse
+
+func (response MergePullRequest401JSONResponse) VisitMergePullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MergePullRequest404JSONResponse ErrorResponse
+
+func (response MergePullRequest404JSONResponse) VisitMergePullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MergePullRequest409JSONResponse ErrorResponse
+
+func (response MergePullRequest409JSONResponse) VisitMergePullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MergePullRequest412JSONResponse struct{ PreconditionFailedJSONResponse }
+
+func (response MergePullRequest412JSONResponse) VisitMergePullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadyPullRequestRequestObject struct {
+	Params ReadyPullRequestParams
+	Body   *ReadyPullRequestJSONRequestBody
+}
+
+type ReadyPullRequestResponseObject interface {
+	VisitReadyPullRequestResponse(w http.ResponseWriter) error
+}
+
+type ReadyPullRequest200ResponseHeaders struct {
+	ETag string
+}
+
+type ReadyPullRequest200JSONResponse struct {
+	Body    CreatePullRequestResponse
+	Headers ReadyPullRequest200ResponseHeaders
+}
+
+func (response ReadyPullRequest200JSONResponse) VisitReadyPullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ReadyPullRequest400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ReadyPullRequest400JSONResponse) VisitReadyPullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadyPullRequest404JSONResponse ErrorResponse
+
+func (response ReadyPullRequest404JSONResponse) VisitReadyPullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadyPullRequest409JSONResponse ErrorResponse
+
+func (response ReadyPullRequest409JSONResponse) VisitReadyPullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadyPullRequest412JSONResponse struct{ PreconditionFailedJSONResponse }
+
+func (response ReadyPullRequest412JSONResponse) VisitReadyPullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReassignPullRequestRequestObject struct {
+	Params ReassignPullRequestParams
+	Body   *ReassignPullRequestJSONRequestBody
+}
+
+type ReassignPullRequestResponseObject interface {
+	VisitReassignPullRequestResponse(w http.ResponseWriter) error
+}
+
+type ReassignPullRequest200ResponseHeaders struct {
+	ETag string
+}
+
+type ReassignPullRequest200JSONResponse struct {
+	Body    ReassignPullRequestResponse
+	Headers ReassignPullRequest200ResponseHeaders
+}
+
+func (response ReassignPullRequest200JSONResponse) VisitReassignPullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ReassignPullRequest400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ReassignPullRequest400JSONResponse) VisitReassignPullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReassignPullRequest404JSONResponse ErrorResponse
+
+func (response ReassignPullRequest404JSONResponse) VisitReassignPullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReassignPullRequest409JSONResponse ErrorResponse
+
+func (response ReassignPullRequest409JSONResponse) VisitReassignPullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReassignPullRequest412JSONResponse struct{ PreconditionFailedJSONResponse }
+
+func (response ReassignPullRequest412JSONResponse) VisitReassignPullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemovePullRequestReviewerRequestObject struct {
+	Params RemovePullRequestReviewerParams
+	Body   *RemovePullRequestReviewerJSONRequestBody
+}
+
+type RemovePullRequestReviewerResponseObject interface {
+	VisitRemovePullRequestReviewerResponse(w http.ResponseWriter) error
+}
+
+type RemovePullRequestReviewer200ResponseHeaders struct {
+	ETag string
+}
+
+type RemovePullRequestReviewer200JSONResponse struct {
+	Body    RemoveReviewerResponse
+	Headers RemovePullRequestReviewer200ResponseHeaders
+}
+
+func (response RemovePullRequestReviewer200JSONResponse) VisitRemovePullRequestReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type RemovePullRequestReviewer400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response RemovePullRequestReviewer400JSONResponse) VisitRemovePullRequestReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemovePullRequestReviewer404JSONResponse ErrorResponse
+
+func (response RemovePullRequestReviewer404JSONResponse) VisitRemovePullRequestReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemovePullRequestReviewer409JSONResponse ErrorResponse
+
+func (response RemovePullRequestReviewer409JSONResponse) VisitRemovePullRequestReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemovePullRequestReviewer412JSONResponse struct{ PreconditionFailedJSONResponse }
+
+func (response RemovePullRequestReviewer412JSONResponse) VisitRemovePullRequestReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReopenPullRequestRequestObject struct {
+	Params ReopenPullRequestParams
+	Body   *ReopenPullRequestJSONRequestBody
+}
+
+type ReopenPullRequestResponseObject interface {
+	VisitReopenPullRequestResponse(w http.ResponseWriter) error
+}
+
+type ReopenPullRequest200ResponseHeaders struct {
+	ETag string
+}
+
+type ReopenPullRequest200JSONResponse struct {
+	Body    PullRequestResponse
+	Headers ReopenPullRequest200ResponseHeaders
+}
+
+func (response ReopenPullRequest200JSONResponse) VisitReopenPullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ReopenPullRequest400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ReopenPullRequest400JSONResponse) VisitReopenPullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReopenPullRequest404JSONResponse ErrorResponse
+
+func (response ReopenPullRequest404JSONResponse) VisitReopenPullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReopenPullRequest409JSONResponse ErrorResponse
+
+func (response ReopenPullRequest409JSONResponse) VisitReopenPullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReopenPullRequest412JSONResponse struct{ PreconditionFailedJSONResponse }
+
+func (response ReopenPullRequest412JSONResponse) VisitReopenPullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SubmitPullRequestReviewRequestObject struct {
+	Params SubmitPullRequestReviewParams
+	Body   *SubmitPullRequestReviewJSONRequestBody
+}
+
+type SubmitPullRequestReviewResponseObject interface {
+	VisitSubmitPullRequestReviewResponse(w http.ResponseWriter) error
+}
+
+type SubmitPullRequestReview200ResponseHeaders struct {
+	ETag string
+}
+
+type SubmitPullRequestReview200JSONResponse struct {
+	Body    PullRequestResponse
+	Headers SubmitPullRequestReview200ResponseHeaders
+}
+
+func (response SubmitPullRequestReview200JSONResponse) VisitSubmitPullRequestReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type SubmitPullRequestReview400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response SubmitPullRequestReview400JSONResponse) VisitSubmitPullRequestReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SubmitPullRequestReview404JSONResponse ErrorResponse
+
+func (response SubmitPullRequestReview404JSONResponse) VisitSubmitPullRequestReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SubmitPullRequestReview409JSONResponse ErrorResponse
+
+func (response SubmitPullRequestReview409JSONResponse) VisitSubmitPullRequestReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SubmitPullRequestReview412JSONResponse struct{ PreconditionFailedJSONResponse }
+
+func (response SubmitPullRequestReview412JSONResponse) VisitSubmitPullRequestReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdatePullRequestRequestObject struct {
+	Params UpdatePullRequestParams
+	Body   *UpdatePullRequestJSONRequestBody
+}
+
+type UpdatePullRequestResponseObject interface {
+	VisitUpdatePullRequestResponse(w http.ResponseWriter) error
+}
+
+type UpdatePullRequest200ResponseHeaders struct {
+	ETag string
+}
+
+type UpdatePullRequest200JSONResponse struct {
+	Body    PullRequestResponse
+	Headers UpdatePullRequest200ResponseHeaders
+}
+
+func (response UpdatePullRequest200JSONResponse) VisitUpdatePullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdatePullRequest400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response UpdatePullRequest400JSONResponse) VisitUpdatePullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdatePullRequest404JSONResponse ErrorResponse
+
+func (response UpdatePullRequest404JSONResponse) VisitUpdatePullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdatePullRequest409JSONResponse ErrorResponse
+
+func (response UpdatePullRequest409JSONResponse) VisitUpdatePullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdatePullRequest412JSONResponse struct{ PreconditionFailedJSONResponse }
+
+func (response UpdatePullRequest412JSONResponse) VisitUpdatePullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddRepositoryRequestObject struct {
+	Body *AddRepositoryJSONRequestBody
+}
+
+type AddRepositoryResponseObject interface {
+	VisitAddRepositoryResponse(w http.ResponseWriter) error
+}
+
+type AddRepository201JSONResponse RepositoryResponse
+
+func (response AddRepository201JSONResponse) VisitAddRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddRepository400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response AddRepository400JSONResponse) VisitAddRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddRepository404JSONResponse ErrorResponse
+
+func (response AddRepository404JSONResponse) VisitAddRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddRepository409JSONResponse ErrorResponse
+
+func (response AddRepository409JSONResponse) VisitAddRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteRepositoryRequestObject struct {
+	Body *DeleteRepositoryJSONRequestBody
+}
+
+type DeleteRepositoryResponseObject interface {
+	VisitDeleteRepositoryResponse(w http.ResponseWriter) error
+}
+
+type DeleteRepository204Response struct {
+}
+
+func (response DeleteRepository204Response) VisitDeleteRepositoryResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteRepository400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response DeleteRepository400JSONResponse) VisitDeleteRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteRepository404JSONResponse ErrorResponse
+
+func (response DeleteRepository404JSONResponse) VisitDeleteRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRepositoryRequestObject struct {
+	Params GetRepositoryParams
+}
+
+type GetRepositoryResponseObject interface {
+	VisitGetRepositoryResponse(w http.ResponseWriter) error
+}
+
+type GetRepository200JSONResponse RepositoryResponse
+
+func (response GetRepository200JSONResponse) VisitGetRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRepository400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetRepository400JSONResponse) VisitGetRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRepository404JSONResponse ErrorResponse
+
+func (response GetRepository404JSONResponse) VisitGetRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRepositoryCodeownersRequestObject struct {
+	Params GetRepositoryCodeownersParams
+}
+
+type GetRepositoryCodeownersResponseObject interface {
+	VisitGetRepositoryCodeownersResponse(w http.ResponseWriter) error
+}
+
+type GetRepositoryCodeowners200JSONResponse CodeOwners
+
+func (response GetRepositoryCodeowners200JSONResponse) VisitGetRepositoryCodeownersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRepositoryCodeowners400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetRepositoryCodeowners400JSONResponse) VisitGetRepositoryCodeownersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRepositoryCodeowners404JSONResponse ErrorResponse
+
+func (response GetRepositoryCodeowners404JSONResponse) VisitGetRepositoryCodeownersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRepositoriesRequestObject struct {
+}
+
+type ListRepositoriesResponseObject interface {
+	VisitListRepositoriesResponse(w http.ResponseWriter) error
+}
+
+type ListRepositories200JSONResponse RepositoriesResponse
+
+func (response ListRepositories200JSONResponse) VisitListRepositoriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetRepositoryCodeownersRequestObject struct {
+	Body *SetRepositoryCodeownersJSONRequestBody
+}
+
+type SetRepositoryCodeownersResponseObject interface {
+	VisitSetRepositoryCodeownersResponse(w http.ResponseWriter) error
+}
+
+type SetRepositoryCodeowners200JSONResponse CodeOwners
+
+func (response SetRepositoryCodeowners200JSONResponse) VisitSetRepositoryCodeownersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetRepositoryCodeowners400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response SetRepositoryCodeowners400JSONResponse) VisitSetRepositoryCodeownersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetRepositoryCodeowners404JSONResponse ErrorResponse
+
+func (response SetRepositoryCodeowners404JSONResponse) VisitSetRepositoryCodeownersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateRepositoryRequestObject struct {
+	Body *UpdateRepositoryJSONRequestBody
+}
+
+type UpdateRepositoryResponseObject interface {
+	VisitUpdateRepositoryResponse(w http.ResponseWriter) error
+}
+
+type UpdateRepository200JSONResponse RepositoryResponse
+
+func (response UpdateRepository200JSONResponse) VisitUpdateRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateRepository400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response UpdateRepository400JSONResponse) VisitUpdateRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateRepository404JSONResponse ErrorResponse
+
+func (response UpdateRepository404JSONResponse) VisitUpdateRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddTeamRequestObject struct {
+	Body *AddTeamJSONRequestBody
+}
+
+type AddTeamResponseObject interface {
+	VisitAddTeamResponse(w http.ResponseWriter) error
+}
+
+type AddTeam201JSONResponse TeamResponse
+
+func (response AddTeam201JSONResponse) VisitAddTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddTeam400JSONResponse ErrorResponse
+
+func (response AddTeam400JSONResponse) VisitAddTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamRequestObject struct {
+	Params GetTeamParams
+}
+
+type GetTeamResponseObject interface {
+	VisitGetTeamResponse(w http.ResponseWriter) error
+}
+
+type GetTeam200JSONResponse Team
+
+func (response GetTeam200JSONResponse) VisitGetTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeam400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetTeam400JSONResponse) VisitGetTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeam404JSONResponse ErrorResponse
+
+func (response GetTeam404JSONResponse) VisitGetTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamCapacityRequestObject struct {
+	Params GetTeamCapacityParams
+}
+
+type GetTeamCapacityResponseObject interface {
+	VisitGetTeamCapacityResponse(w http.ResponseWriter) error
+}
+
+type GetTeamCapacity200JSONResponse TeamCapacity
+
+func (response GetTeamCapacity200JSONResponse) VisitGetTeamCapacityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamCapacity400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetTeamCapacity400JSONResponse) VisitGetTeamCapacityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamCapacity404JSONResponse ErrorResponse
+
+func (response GetTeamCapacity404JSONResponse) VisitGetTeamCapacityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamMergePolicyRequestObject struct {
+	Params GetTeamMergePolicyParams
+}
+
+type GetTeamMergePolicyResponseObject interface {
+	VisitGetTeamMergePolicyResponse(w http.ResponseWriter) error
+}
+
+type GetTeamMergePolicy200JSONResponse TeamMergePolicy
+
+func (response GetTeamMergePolicy200JSONResponse) VisitGetTeamMergePolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamMergePolicy400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetTeamMergePolicy400JSONResponse) VisitGetTeamMergePolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamMergePolicy404JSONResponse ErrorResponse
+
+func (response GetTeamMergePolicy404JSONResponse) VisitGetTeamMergePolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamReviewRulesRequestObject struct {
+	Params GetTeamReviewRulesParams
+}
+
+type GetTeamReviewRulesResponseObject interface {
+	VisitGetTeamReviewRulesResponse(w http.ResponseWriter) error
+}
+
+type GetTeamReviewRules200JSONResponse TeamReviewRules
+
+func (response GetTeamReviewRules200JSONResponse) VisitGetTeamReviewRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamReviewRules400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetTeamReviewRules400JSONResponse) VisitGetTeamReviewRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamReviewRules404JSONResponse ErrorResponse
+
+func (response GetTeamReviewRules404JSONResponse) VisitGetTeamReviewRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetTeamMaxOpenReviewsRequestObject struct {
+	Body *SetTeamMaxOpenReviewsJSONRequestBody
+}
+
+type SetTeamMaxOpenReviewsResponseObject interface {
+	VisitSetTeamMaxOpenReviewsResponse(w http.ResponseWriter) error
+}
+
+type SetTeamMaxOpenReviews200JSONResponse TeamCapacity
+
+func (response SetTeamMaxOpenReviews200JSONResponse) VisitSetTeamMaxOpenReviewsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetTeamMaxOpenReviews400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response SetTeamMaxOpenReviews400JSONResponse) VisitSetTeamMaxOpenReviewsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetTeamMaxOpenReviews404JSONResponse ErrorResponse
+
+func (response SetTeamMaxOpenReviews404JSONResponse) VisitSetTeamMaxOpenReviewsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetTeamMergePolicyRequestObject struct {
+	Body *SetTeamMergePolicyJSONRequestBody
+}
+
+type SetTeamMergePolicyResponseObject interface {
+	VisitSetTeamMergePolicyResponse(w http.ResponseWriter) error
+}
+
+type SetTeamMergePolicy200JSONResponse TeamMergePolicy
+
+func (response SetTeamMergePolicy200JSONResponse) VisitSetTeamMergePolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetTeamMergePolicy400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response SetTeamMergePolicy400JSONResponse) VisitSetTeamMergePolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetTeamMergePolicy404JSONResponse ErrorResponse
+
+func (response SetTeamMergePolicy404JSONResponse) VisitSetTeamMergePolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetTeamReviewRulesRequestObject struct {
+	Body *SetTeamReviewRulesJSONRequestBody
+}
+
+type SetTeamReviewRulesResponseObject interface {
+	VisitSetTeamReviewRulesResponse(w http.ResponseWriter) error
+}
+
+type SetTeamReviewRules200JSONResponse TeamReviewRules
+
+func (response SetTeamReviewRules200JSONResponse) VisitSetTeamReviewRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetTeamReviewRules400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response SetTeamReviewRules400JSONResponse) VisitSetTeamReviewRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetTeamReviewRules404JSONResponse ErrorResponse
+
+func (response SetTeamReviewRules404JSONResponse) VisitSetTeamReviewRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetTeamWorkingHoursPolicyRequestObject struct {
+	Body *SetTeamWorkingHoursPolicyJSONRequestBody
+}
+
+type SetTeamWorkingHoursPolicyResponseObject interface {
+	VisitSetTeamWorkingHoursPolicyResponse(w http.ResponseWriter) error
+}
+
+type SetTeamWorkingHoursPolicy200JSONResponse TeamWorkingHoursPolicy
+
+func (response SetTeamWorkingHoursPolicy200JSONResponse) VisitSetTeamWorkingHoursPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetTeamWorkingHoursPolicy400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response SetTeamWorkingHoursPolicy400JSONResponse) VisitSetTeamWorkingHoursPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetTeamWorkingHoursPolicy404JSONResponse ErrorResponse
+
+func (response SetTeamWorkingHoursPolicy404JSONResponse) VisitSetTeamWorkingHoursPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddUserOutOfOfficeRequestObject struct {
+	Body *AddUserOutOfOfficeJSONRequestBody
+}
+
+type AddUserOutOfOfficeResponseObject interface {
+	VisitAddUserOutOfOfficeResponse(w http.ResponseWriter) error
+}
+
+type AddUserOutOfOffice201JSONResponse OutOfOffice
+
+func (response AddUserOutOfOffice201JSONResponse) VisitAddUserOutOfOfficeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddUserOutOfOffice400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response AddUserOutOfOffice400JSONResponse) VisitAddUserOutOfOfficeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddUserOutOfOffice404JSONResponse ErrorResponse
+
+func (response AddUserOutOfOffice404JSONResponse) VisitAddUserOutOfOfficeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUserOutOfOfficeRequestObject struct {
+	Body *DeleteUserOutOfOfficeJSONRequestBody
+}
+
+type DeleteUserOutOfOfficeResponseObject interface {
+	VisitDeleteUserOutOfOfficeResponse(w http.ResponseWriter) error
+}
+
+type DeleteUserOutOfOffice204Response struct {
+}
+
+func (response DeleteUserOutOfOffice204Response) VisitDeleteUserOutOfOfficeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteUserOutOfOffice400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response DeleteUserOutOfOffice400JSONResponse) VisitDeleteUserOutOfOfficeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUserOutOfOffice404JSONResponse ErrorResponse
+
+func (response DeleteUserOutOfOffice404JSONResponse) VisitDeleteUserOutOfOfficeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUserWorkingHoursRequestObject struct {
+	Body *DeleteUserWorkingHoursJSONRequestBody
+}
+
+type DeleteUserWorkingHoursResponseObject interface {
+	VisitDeleteUserWorkingHoursResponse(w http.ResponseWriter) error
+}
+
+type DeleteUserWorkingHours204Response struct {
+}
+
+func (response DeleteUserWorkingHours204Response) VisitDeleteUserWorkingHoursResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteUserWorkingHours400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response DeleteUserWorkingHours400JSONResponse) VisitDeleteUserWorkingHoursResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUserWorkingHours404JSONResponse ErrorResponse
+
+func (response DeleteUserWorkingHours404JSONResponse) VisitDeleteUserWorkingHoursResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserOutOfOfficeRequestObject struct {
+	Params GetUserOutOfOfficeParams
+}
+
+type GetUserOutOfOfficeResponseObject interface {
+	VisitGetUserOutOfOfficeResponse(w http.ResponseWriter) error
+}
+
+type GetUserOutOfOffice200JSONResponse OutOfOfficeListResponse
+
+func (response GetUserOutOfOffice200JSONResponse) VisitGetUserOutOfOfficeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserOutOfOffice400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetUserOutOfOffice400JSONResponse) VisitGetUserOutOfOfficeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserOutOfOffice404JSONResponse ErrorResponse
+
+func (response GetUserOutOfOffice404JSONResponse) VisitGetUserOutOfOfficeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserReviewsRequestObject struct {
+	Params GetUserReviewsParams
+}
+
+type GetUserReviewsResponseObject interface {
+	VisitGetUserReviewsResponse(w http.ResponseWriter) error
+}
+
+type GetUserReviews200JSONResponse UserReviewsResponse
+
+func (response GetUserReviews200JSONResponse) VisitGetUserReviewsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserReviews400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetUserReviews400JSONResponse) VisitGetUserReviewsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserReviews404JSONResponse ErrorResponse
+
+func (response GetUserReviews404JSONResponse) VisitGetUserReviewsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserSkillsRequestObject struct {
+	Params GetUserSkillsParams
+}
+
+type GetUserSkillsResponseObject interface {
+	VisitGetUserSkillsResponse(w http.ResponseWriter) error
+}
+
+type GetUserSkills200JSONResponse UserSkills
+
+func (response GetUserSkills200JSONResponse) VisitGetUserSkillsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserSkills400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetUserSkills400JSONResponse) VisitGetUserSkillsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserSkills404JSONResponse ErrorResponse
+
+func (response GetUserSkills404JSONResponse) VisitGetUserSkillsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserWorkingHoursRequestObject struct {
+	Params GetUserWorkingHoursParams
+}
+
+type GetUserWorkingHoursResponseObject interface {
+	VisitGetUserWorkingHoursResponse(w http.ResponseWriter) error
+}
+
+type GetUserWorkingHours200JSONResponse WorkingHours
+
+func (response GetUserWorkingHours200JSONResponse) VisitGetUserWorkingHoursResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserWorkingHours400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetUserWorkingHours400JSONResponse) VisitGetUserWorkingHoursResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserWorkingHours404JSONResponse ErrorResponse
+
+func (response GetUserWorkingHours404JSONResponse) VisitGetUserWorkingHoursResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserIsActiveRequestObject struct {
+	Params SetUserIsActiveParams
+	Body   *SetUserIsActiveJSONRequestBody
+}
+
+type SetUserIsActiveResponseObject interface {
+	VisitSetUserIsActiveResponse(w http.ResponseWriter) error
+}
+
+type SetUserIsActive200ResponseHeaders struct {
+	ETag string
+}
+
+type SetUserIsActive200JSONResponse struct {
+	Body    UserResponse
+	Headers SetUserIsActive200ResponseHeaders
+}
+
+func (response SetUserIsActive200JSONResponse) VisitSetUserIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type SetUserIsActive400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response SetUserIsActive400JSONResponse) VisitSetUserIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserIsActive401JSONResponse ErrorResponse
+
+func (response SetUserIsActive401JSONResponse) VisitSetUserIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserIsActive404JSONResponse ErrorResponse
+
+func (response SetUserIsActive404JSONResponse) VisitSetUserIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserIsActive412JSONResponse struct{ PreconditionFailedJSONResponse }
+
+func (response SetUserIsActive412JSONResponse) VisitSetUserIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserMaxOpenReviewsRequestObject struct {
+	Params SetUserMaxOpenReviewsParams
+	Body   *SetUserMaxOpenReviewsJSONRequestBody
+}
+
+type SetUserMaxOpenReviewsResponseObject interface {
+	VisitSetUserMaxOpenReviewsResponse(w http.ResponseWriter) error
+}
+
+type SetUserMaxOpenReviews200ResponseHeaders struct {
+	ETag string
+}
+
+type SetUserMaxOpenReviews200JSONResponse struct {
+	Body    ReviewerCapacity
+	Headers SetUserMaxOpenReviews200ResponseHeaders
+}
+
+func (response SetUserMaxOpenReviews200JSONResponse) VisitSetUserMaxOpenReviewsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type SetUserMaxOpenReviews400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response SetUserMaxOpenReviews400JSONResponse) VisitSetUserMaxOpenReviewsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserMaxOpenReviews404JSONResponse ErrorResponse
+
+func (response SetUserMaxOpenReviews404JSONResponse) VisitSetUserMaxOpenReviewsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserMaxOpenReviews412JSONResponse struct{ PreconditionFailedJSONResponse }
+
+func (response SetUserMaxOpenReviews412JSONResponse) VisitSetUserMaxOpenReviewsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserSkillsRequestObject struct {
+	Params SetUserSkillsParams
+	Body   *SetUserSkillsJSONRequestBody
+}
+
+type SetUserSkillsResponseObject interface {
+	VisitSetUserSkillsResponse(w http.ResponseWriter) error
+}
+
+type SetUserSkills200ResponseHeaders struct {
+	ETag string
+}
+
+type SetUserSkills200JSONResponse struct {
+	Body    UserSkills
+	Headers SetUserSkills200ResponseHeaders
+}
+
+func (response SetUserSkills200JSONResponse) VisitSetUserSkillsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type SetUserSkills400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response SetUserSkills400JSONResponse) VisitSetUserSkillsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserSkills404JSONResponse ErrorResponse
+
+func (response SetUserSkills404JSONResponse) VisitSetUserSkillsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserSkills412JSONResponse struct{ PreconditionFailedJSONResponse }
+
+func (response SetUserSkills412JSONResponse) VisitSetUserSkillsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserWorkingHoursRequestObject struct {
+	Body *SetUserWorkingHoursJSONRequestBody
+}
+
+type SetUserWorkingHoursResponseObject interface {
+	VisitSetUserWorkingHoursResponse(w http.ResponseWriter) error
+}
+
+type SetUserWorkingHours200JSONResponse WorkingHours
+
+func (response SetUserWorkingHours200JSONResponse) VisitSetUserWorkingHoursResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserWorkingHours400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response SetUserWorkingHours400JSONResponse) VisitSetUserWorkingHoursResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserWorkingHours404JSONResponse ErrorResponse
+
+func (response SetUserWorkingHours404JSONResponse) VisitSetUserWorkingHoursResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhookDeliveriesRequestObject struct {
+	Params GetWebhookDeliveriesParams
+}
+
+type GetWebhookDeliveriesResponseObject interface {
+	VisitGetWebhookDeliveriesResponse(w http.ResponseWriter) error
+}
+
+type GetWebhookDeliveries200JSONResponse WebhookDeliveriesResponse
+
+func (response GetWebhookDeliveries200JSONResponse) VisitGetWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhookDeliveries400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetWebhookDeliveries400JSONResponse) VisitGetWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhookDeliveries404JSONResponse ErrorResponse
+
+func (response GetWebhookDeliveries404JSONResponse) VisitGetWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SubscribeWebhookRequestObject struct {
+	Body *SubscribeWebhookJSONRequestBody
+}
+
+type SubscribeWebhookResponseObject interface {
+	VisitSubscribeWebhookResponse(w http.ResponseWriter) error
+}
+
+type SubscribeWebhook201JSONResponse WebhookSubscriptionResponse
+
+func (response SubscribeWebhook201JSONResponse) VisitSubscribeWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SubscribeWebhook400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response SubscribeWebhook400JSONResponse) VisitSubscribeWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UnsubscribeWebhookRequestObject struct {
+	Body *UnsubscribeWebhookJSONRequestBody
+}
+
+type UnsubscribeWebhookResponseObject interface {
+	VisitUnsubscribeWebhookResponse(w http.ResponseWriter) error
+}
+
+type UnsubscribeWebhook204Response struct {
+}
+
+func (response UnsubscribeWebhook204Response) VisitUnsubscribeWebhookResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type UnsubscribeWebhook400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response UnsubscribeWebhook400JSONResponse) VisitUnsubscribeWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UnsubscribeWebhook404JSONResponse ErrorResponse
+
+func (response UnsubscribeWebhook404JSONResponse) VisitUnsubscribeWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Связать логин на GitHub/GitLab с пользователем сервиса
+	// (POST /integrations/accounts/link)
+	LinkForgeAccount(ctx context.Context, request LinkForgeAccountRequestObject) (LinkForgeAccountResponseObject, error)
+	// Разрешить приём событий из репозитория; события остальных репозиториев игнорируются
+	// (POST /integrations/repositories/add)
+	AddForgeRepository(ctx context.Context, request AddForgeRepositoryRequestObject) (AddForgeRepositoryResponseObject, error)
+	// Вручную назначить ревьювера открытому PR
+	// (POST /pullRequest/addReviewer)
+	AddPullRequestReviewer(ctx context.Context, request AddPullRequestReviewerRequestObject) (AddPullRequestReviewerResponseObject, error)
+	// Закрыть PR без merge
+	// (POST /pullRequest/close)
+	ClosePullRequest(ctx context.Context, request ClosePullRequestRequestObject) (ClosePullRequestResponseObject, error)
+	// Создать PR и автоматически назначить ревьюверов
+	// (POST /pullRequest/create)
+	CreatePullRequest(ctx context.Context, request CreatePullRequestRequestObject) (CreatePullRequestResponseObject, error)
+	// Отказаться от ревью
+	// (POST /pullRequest/decline)
+	DeclinePullRequestReview(ctx context.Context, request DeclinePullRequestReviewRequestObject) (DeclinePullRequestReviewResponseObject, error)
+	// Журнал аудита PR
+	// (GET /pullRequest/getAudit)
+	GetPullRequestAudit(ctx context.Context, request GetPullRequestAuditRequestObject) (GetPullRequestAuditResponseObject, error)
+	// Пометить PR как MERGED (идемпотентная операция)
+	// (POST /pullRequest/merge)
+	MergePullRequest(ctx context.Context, request MergePullRequestRequestObject) (MergePullRequestResponseObject, error)
+	// Отметить черновик готовым к ревью
+	// (POST /pullRequest/ready)
+	ReadyPullRequest(ctx context.Context, request ReadyPullRequestRequestObject) (ReadyPullRequestResponseObject, error)
+	// Переназначить конкретного ревьювера на другого из его команды или на указанного пользователя
+	// (POST /pullRequest/reassign)
+	ReassignPullRequest(ctx context.Context, request ReassignPullRequestRequestObject) (ReassignPullRequestResponseObject, error)
+	// Снять ревьювера с открытого PR
+	// (POST /pullRequest/removeReviewer)
+	RemovePullRequestReviewer(ctx context.Context, request RemovePullRequestReviewerRequestObject) (RemovePullRequestReviewerResponseObject, error)
+	// Снова открыть закрытый PR
+	// (POST /pullRequest/reopen)
+	ReopenPullRequest(ctx context.Context, request ReopenPullRequestRequestObject) (ReopenPullRequestResponseObject, error)
+	// Записать вердикт назначенного ревьювера
+	// (POST /pullRequest/review)
+	SubmitPullRequestReview(ctx context.Context, request SubmitPullRequestReviewRequestObject) (SubmitPullRequestReviewResponseObject, error)
+	// Изменить название, автора, описание и метки PR
+	// (PATCH /pullRequest/update)
+	UpdatePullRequest(ctx context.Context, request UpdatePullRequestRequestObject) (UpdatePullRequestResponseObject, error)
+	// Создать репозиторий с командами-владельцами и пулом ревьюверов
+	// (POST /repositories/add)
+	AddRepository(ctx context.Context, request AddRepositoryRequestObject) (AddRepositoryResponseObject, error)
+	// Удалить репозиторий вместе с CODEOWNERS; PR остаются без репозитория
+	// (POST /repositories/delete)
+	DeleteRepository(ctx context.Context, request DeleteRepositoryRequestObject) (DeleteRepositoryResponseObject, error)
+	// Получить репозиторий
+	// (GET /repositories/get)
+	GetRepository(ctx context.Context, request GetRepositoryRequestObject) (GetRepositoryResponseObject, error)
+	// Получить CODEOWNERS репозитория
+	// (GET /repositories/getCodeowners)
+	GetRepositoryCodeowners(ctx context.Context, request GetRepositoryCodeownersRequestObject) (GetRepositoryCodeownersResponseObject, error)
+	// Список репозиториев
+	// (GET /repositories/list)
+	ListRepositories(ctx context.Context, request ListRepositoriesRequestObject) (ListRepositoriesResponseObject, error)
+	// Зарегистрировать (заменить) CODEOWNERS репозитория
+	// (POST /repositories/setCodeowners)
+	SetRepositoryCodeowners(ctx context.Context, request SetRepositoryCodeownersRequestObject) (SetRepositoryCodeownersResponseObject, error)
+	// Заменить название, команды-владельцы, пул ревьюверов и настройки репозитория
+	// (POST /repositories/update)
+	UpdateRepository(ctx context.Context, request UpdateRepositoryRequestObject) (UpdateRepositoryResponseObject, error)
+	// Создать команду с участниками (создаёт/обновляет пользователей)
+	// (POST /team/add)
+	AddTeam(ctx context.Context, request AddTeamRequestObject) (AddTeamResponseObject, error)
+	// Получить команду с участниками
+	// (GET /team/get)
+	GetTeam(ctx context.Context, request GetTeamRequestObject) (GetTeamResponseObject, error)
+	// Загрузка участников команды и оставшаяся ёмкость для ревью
+	// (GET /team/getCapacity)
+	GetTeamCapacity(ctx context.Context, request GetTeamCapacityRequestObject) (GetTeamCapacityResponseObject, error)
+	// Получить условия merge команды
+	// (GET /team/getMergePolicy)
+	GetTeamMergePolicy(ctx context.Context, request GetTeamMergePolicyRequestObject) (GetTeamMergePolicyResponseObject, error)
+	// Получить правила ревью команды
+	// (GET /team/getReviewRules)
+	GetTeamReviewRules(ctx context.Context, request GetTeamReviewRulesRequestObject) (GetTeamReviewRulesResponseObject, error)
+	// Задать лимит открытых ревью по умолчанию для участников команды
+	// (POST /team/setMaxOpenReviews)
+	SetTeamMaxOpenReviews(ctx context.Context, request SetTeamMaxOpenReviewsRequestObject) (SetTeamMaxOpenReviewsResponseObject, error)
+	// Задать условия merge PR, авторы которых состоят в команде
+	// (POST /team/setMergePolicy)
+	SetTeamMergePolicy(ctx context.Context, request SetTeamMergePolicyRequestObject) (SetTeamMergePolicyResponseObject, error)
+	// Задать правила ревью PR авторов команды по меткам и приоритету
+	// (POST /team/setReviewRules)
+	SetTeamReviewRules(ctx context.Context, request SetTeamReviewRulesRequestObject) (SetTeamReviewRulesResponseObject, error)
+	// Задать, как при назначении ревьюверов учитываются рабочие часы участников команды
+	// (POST /team/setWorkingHoursPolicy)
+	SetTeamWorkingHoursPolicy(ctx context.Context, request SetTeamWorkingHoursPolicyRequestObject) (SetTeamWorkingHoursPolicyResponseObject, error)
+	// Добавить период отсутствия пользователя
+	// (POST /users/addOutOfOffice)
+	AddUserOutOfOffice(ctx context.Context, request AddUserOutOfOfficeRequestObject) (AddUserOutOfOfficeResponseObject, error)
+	// Удалить период отсутствия
+	// (POST /users/deleteOutOfOffice)
+	DeleteUserOutOfOffice(ctx context.Context, request DeleteUserOutOfOfficeRequestObject) (DeleteUserOutOfOfficeResponseObject, error)
+	// Удалить рабочие часы; пользователь снова доступен в любое время
+	// (POST /users/deleteWorkingHours)
+	DeleteUserWorkingHours(ctx context.Context, request DeleteUserWorkingHoursRequestObject) (DeleteUserWorkingHoursResponseObject, error)
+	// Текущие и будущие периоды отсутствия пользователя
+	// (GET /users/getOutOfOffice)
+	GetUserOutOfOffice(ctx context.Context, request GetUserOutOfOfficeRequestObject) (GetUserOutOfOfficeResponseObject, error)
+	// Получить PR'ы, где пользователь назначен ревьювером
+	// (GET /users/getReview)
+	GetUserReviews(ctx context.Context, request GetUserReviewsRequestObject) (GetUserReviewsResponseObject, error)
+	// Получить навыки пользователя
+	// (GET /users/getSkills)
+	GetUserSkills(ctx context.Context, request GetUserSkillsRequestObject) (GetUserSkillsResponseObject, error)
+	// Получить рабочие часы пользователя
+	// (GET /users/getWorkingHours)
+	GetUserWorkingHours(ctx context.Context, request GetUserWorkingHoursRequestObject) (GetUserWorkingHoursResponseObject, error)
+	// Установить флаг активности пользователя
+	// (POST /users/setIsActive)
+	SetUserIsActive(ctx context.Context, request SetUserIsActiveRequestObject) (SetUserIsActiveResponseObject, error)
+	// Задать пользователю собственный лимит открытых ревью
+	// (POST /users/setMaxOpenReviews)
+	SetUserMaxOpenReviews(ctx context.Context, request SetUserMaxOpenReviewsRequestObject) (SetUserMaxOpenReviewsResponseObject, error)
+	// Заменить навыки пользователя
+	// (POST /users/setSkills)
+	SetUserSkills(ctx context.Context, request SetUserSkillsRequestObject) (SetUserSkillsResponseObject, error)
+	// Задать часовой пояс и рабочие часы пользователя
+	// (POST /users/setWorkingHours)
+	SetUserWorkingHours(ctx context.Context, request SetUserWorkingHoursRequestObject) (SetUserWorkingHoursResponseObject, error)
+	// Журнал попыток доставки по подписке (новые сверху)
+	// (GET /webhooks/getDeliveries)
+	GetWebhookDeliveries(ctx context.Context, request GetWebhookDeliveriesRequestObject) (GetWebhookDeliveriesResponseObject, error)
+	// Подписать URL на события PR. Тело доставки подписывается в заголовке X-Webhook-Signature-256
+	// (POST /webhooks/subscribe)
+	SubscribeWebhook(ctx context.Context, request SubscribeWebhookRequestObject) (SubscribeWebhookResponseObject, error)
+	// Удалить подписку вместе с журналом доставок
+	// (POST /webhooks/unsubscribe)
+	UnsubscribeWebhook(ctx context.Context, request UnsubscribeWebhookRequestObject) (UnsubscribeWebhookResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// LinkForgeAccount operation middleware
+func (sh *strictHandler) LinkForgeAccount(w http.ResponseWriter, r *http.Request) {
+	var request LinkForgeAccountRequestObject
+
+	var body LinkForgeAccountJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.LinkForgeAccount(ctx, request.(LinkForgeAccountRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "LinkForgeAccount")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(LinkForgeAccountResponseObject); ok {
+		if err := validResponse.VisitLinkForgeAccountResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AddForgeRepository operation middleware
+func (sh *strictHandler) AddForgeRepository(w http.ResponseWriter, r *http.Request) {
+	var request AddForgeRepositoryRequestObject
+
+	var body AddForgeRepositoryJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AddForgeRepository(ctx, request.(AddForgeRepositoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AddForgeRepository")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AddForgeRepositoryResponseObject); ok {
+		if err := validResponse.VisitAddForgeRepositoryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AddPullRequestReviewer operation middleware
+func (sh *strictHandler) AddPullRequestReviewer(w http.ResponseWriter, r *http.Request, params AddPullRequestReviewerParams) {
+	var request AddPullRequestReviewerRequestObject
+
+	request.Params = params
+
+	var body AddPullRequestReviewerJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AddPullRequestReviewer(ctx, request.(AddPullRequestReviewerRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AddPullRequestReviewer")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AddPullRequestReviewerResponseObject); ok {
+		if err := validResponse.VisitAddPullRequestReviewerResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ClosePullRequest operation middleware
+func (sh *strictHandler) ClosePullRequest(w http.ResponseWriter, r *http.Request, params ClosePullRequestParams) {
+	var request ClosePullRequestRequestObject
+
+	request.Params = params
+
+	var body ClosePullRequestJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ClosePullRequest(ctx, request.(ClosePullRequestRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ClosePullRequest")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ClosePullRequestResponseObject); ok {
+		if err := validResponse.VisitClosePullRequestResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreatePullRequest operation middleware
+func (sh *strictHandler) CreatePullRequest(w http.ResponseWriter, r *http.Request) {
+	var request CreatePullRequestRequestObject
+
+	var body CreatePullRequestJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreatePullRequest(ctx, request.(CreatePullRequestRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreatePullRequest")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreatePullRequestResponseObject); ok {
+		if err := validResponse.VisitCreatePullRequestResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeclinePullRequestReview operation middleware
+func (sh *strictHandler) DeclinePullRequestReview(w http.ResponseWriter, r *http.Request, params DeclinePullRequestReviewParams) {
+	var request DeclinePullRequestReviewRequestObject
+
+	request.Params = params
+
+	var body DeclinePullRequestReviewJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeclinePullRequestReview(ctx, request.(DeclinePullRequestReviewRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeclinePullRequestReview")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeclinePullRequestReviewResponseObject); ok {
+		if err := validResponse.VisitDeclinePullRequestReviewResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetPullRequestAudit operation middleware
+func (sh *strictHandler) GetPullRequestAudit(w http.ResponseWriter, r *http.Request, params GetPullRequestAuditParams) {
+	var request GetPullRequestAuditRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPullRequestAudit(ctx, request.(GetPullRequestAuditRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPullRequestAudit")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPullRequestAuditResponseObject); ok {
+		if err := validResponse.VisitGetPullRequestAuditResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// MergePullRequest operation middleware
+func (sh *strictHandler) MergePullRequest(w http.ResponseWriter, r *http.Request, params MergePullRequestParams) {
+	var request MergePullRequestRequestObject
+
+	request.Params = params
+
+	var body MergePullRequestJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.MergePullRequest(ctx, request.(MergePullRequestRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "MergePullRequest")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(MergePullRequestResponseObject); ok {
+		if err := validResponse.VisitMergePullRequestResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ReadyPullRequest operation middleware
+func (sh *strictHandler) ReadyPullRequest(w http.ResponseWriter, r *http.Request, params ReadyPullRequestParams) {
+	var request ReadyPullRequestRequestObject
+
+	request.Params = params
+
+	var body ReadyPullRequestJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ReadyPullRequest(ctx, request.(ReadyPullRequestRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ReadyPullRequest")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ReadyPullRequestResponseObject); ok {
+		if err := validResponse.VisitReadyPullRequestResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ReassignPullRequest operation middleware
+func (sh *strictHandler) ReassignPullRequest(w http.ResponseWriter, r *http.Request, params ReassignPullRequestParams) {
+	var request ReassignPullRequestRequestObject
+
+	request.Params = params
+
+	var body ReassignPullRequestJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ReassignPullRequest(ctx, request.(ReassignPullRequestRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ReassignPullRequest")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ReassignPullRequestResponseObject); ok {
+		if err := validResponse.VisitReassignPullRequestResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RemovePullRequestReviewer operation middleware
+func (sh *strictHandler) RemovePullRequestReviewer(w http.ResponseWriter, r *http.Request, params RemovePullRequestReviewerParams) {
+	var request RemovePullRequestReviewerRequestObject
+
+	request.Params = params
+
+	var body RemovePullRequestReviewerJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RemovePullRequestReviewer(ctx, request.(RemovePullRequestReviewerRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RemovePullRequestReviewer")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RemovePullRequestReviewerResponseObject); ok {
+		if err := validResponse.VisitRemovePullRequestReviewerResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ReopenPullRequest operation middleware
+func (sh *strictHandler) ReopenPullRequest(w http.ResponseWriter, r *http.Request, params ReopenPullRequestParams) {
+	var request ReopenPullRequestRequestObject
+
+	request.Params = params
+
+	var body ReopenPullRequestJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ReopenPullRequest(ctx, request.(ReopenPullRequestRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ReopenPullRequest")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ReopenPullRequestResponseObject); ok {
+		if err := validResponse.VisitReopenPullRequestResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SubmitPullRequestReview operation middleware
+func (sh *strictHandler) SubmitPullRequestReview(w http.ResponseWriter, r *http.Request, params SubmitPullRequestReviewParams) {
+	var request SubmitPullRequestReviewRequestObject
+
+	request.Params = params
+
+	var body SubmitPullRequestReviewJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SubmitPullRequestReview(ctx, request.(SubmitPullRequestReviewRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SubmitPullRequestReview")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SubmitPullRequestReviewResponseObject); ok {
+		if err := validResponse.VisitSubmitPullRequestReviewResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdatePullRequest operation middleware
+func (sh *strictHandler) UpdatePullRequest(w http.ResponseWriter, r *http.Request, params UpdatePullRequestParams) {
+	var request UpdatePullRequestRequestObject
+
+	request.Params = params
+
+	var body UpdatePullRequestJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdatePullRequest(ctx, request.(UpdatePullRequestRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdatePullRequest")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdatePullRequestResponseObject); ok {
+		if err := validResponse.VisitUpdatePullRequestResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AddRepository operation middleware
+func (sh *strictHandler) AddRepository(w http.ResponseWriter, r *http.Request) {
+	var request AddRepositoryRequestObject
+
+	var body AddRepositoryJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AddRepository(ctx, request.(AddRepositoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AddRepository")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AddRepositoryResponseObject); ok {
+		if err := validResponse.VisitAddRepositoryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteRepository operation middleware
+func (sh *strictHandler) DeleteRepository(w http.ResponseWriter, r *http.Request) {
+	var request DeleteRepositoryRequestObject
+
+	var body DeleteRepositoryJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteRepository(ctx, request.(DeleteRepositoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteRepository")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteRepositoryResponseObject); ok {
+		if err := validResponse.VisitDeleteRepositoryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetRepository operation middleware
+func (sh *strictHandler) GetRepository(w http.ResponseWriter, r *http.Request, params GetRepositoryParams) {
+	var request GetRepositoryRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetRepository(ctx, request.(GetRepositoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetRepository")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetRepositoryResponseObject); ok {
+		if err := validResponse.VisitGetRepositoryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetRepositoryCodeowners operation middleware
+func (sh *strictHandler) GetRepositoryCodeowners(w http.ResponseWriter, r *http.Request, params GetRepositoryCodeownersParams) {
+	var request GetRepositoryCodeownersRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetRepositoryCodeowners(ctx, request.(GetRepositoryCodeownersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetRepositoryCodeowners")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetRepositoryCodeownersResponseObject); ok {
+		if err := validResponse.VisitGetRepositoryCodeownersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListRepositories operation middleware
+func (sh *strictHandler) ListRepositories(w http.ResponseWriter, r *http.Request) {
+	var request ListRepositoriesRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListRepositories(ctx, request.(ListRepositoriesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListRepositories")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListRepositoriesResponseObject); ok {
+		if err := validResponse.VisitListRepositoriesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SetRepositoryCodeowners operation middleware
+func (sh *strictHandler) SetRepositoryCodeowners(w http.ResponseWriter, r *http.Request) {
+	var request SetRepositoryCodeownersRequestObject
+
+	var body SetRepositoryCodeownersJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SetRepositoryCodeowners(ctx, request.(SetRepositoryCodeownersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetRepositoryCodeowners")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SetRepositoryCodeownersResponseObject); ok {
+		if err := validResponse.VisitSetRepositoryCodeownersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateRepository operation middleware
+func (sh *strictHandler) UpdateRepository(w http.ResponseWriter, r *http.Request) {
+	var request UpdateRepositoryRequestObject
+
+	var body UpdateRepositoryJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateRepository(ctx, request.(UpdateRepositoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateRepository")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateRepositoryResponseObject); ok {
+		if err := validResponse.VisitUpdateRepositoryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AddTeam operation middleware
+func (sh *strictHandler) AddTeam(w http.ResponseWriter, r *http.Request) {
+	var request AddTeamRequestObject
+
+	var body AddTeamJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AddTeam(ctx, request.(AddTeamRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AddTeam")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AddTeamResponseObject); ok {
+		if err := validResponse.VisitAddTeamResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetTeam operation middleware
+func (sh *strictHandler) GetTeam(w http.ResponseWriter, r *http.Request, params GetTeamParams) {
+	var request GetTeamRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTeam(ctx, request.(GetTeamRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTeam")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTeamResponseObject); ok {
+		if err := validResponse.VisitGetTeamResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetTeamCapacity operation middleware
+func (sh *strictHandler) GetTeamCapacity(w http.ResponseWriter, r *http.Request, params GetTeamCapacityParams) {
+	var request GetTeamCapacityRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTeamCapacity(ctx, request.(GetTeamCapacityRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTeamCapacity")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTeamCapacityResponseObject); ok {
+		if err := validResponse.VisitGetTeamCapacityResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetTeamMergePolicy operation middleware
+func (sh *strictHandler) GetTeamMergePolicy(w http.ResponseWriter, r *http.Request, params GetTeamMergePolicyParams) {
+	var request GetTeamMergePolicyRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTeamMergePolicy(ctx, request.(GetTeamMergePolicyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTeamMergePolicy")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTeamMergePolicyResponseObject); ok {
+		if err := validResponse.VisitGetTeamMergePolicyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetTeamReviewRules operation middleware
+func (sh *strictHandler) GetTeamReviewRules(w http.ResponseWriter, r *http.Request, params GetTeamReviewRulesParams) {
+	var request GetTeamReviewRulesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTeamReviewRules(ctx, request.(GetTeamReviewRulesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTeamReviewRules")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTeamReviewRulesResponseObject); ok {
+		if err := validResponse.VisitGetTeamReviewRulesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SetTeamMaxOpenReviews operation middleware
+func (sh *strictHandler) SetTeamMaxOpenReviews(w http.ResponseWriter, r *http.Request) {
+	var request SetTeamMaxOpenReviewsRequestObject
+
+	var body SetTeamMaxOpenReviewsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SetTeamMaxOpenReviews(ctx, request.(SetTeamMaxOpenReviewsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetTeamMaxOpenReviews")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SetTeamMaxOpenReviewsResponseObject); ok {
+		if err := validResponse.VisitSetTeamMaxOpenReviewsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SetTeamMergePolicy operation middleware
+func (sh *strictHandler) SetTeamMergePolicy(w http.ResponseWriter, r *http.Request) {
+	var request SetTeamMergePolicyRequestObject
+
+	var body SetTeamMergePolicyJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SetTeamMergePolicy(ctx, request.(SetTeamMergePolicyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetTeamMergePolicy")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SetTeamMergePolicyResponseObject); ok {
+		if err := validResponse.VisitSetTeamMergePolicyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SetTeamReviewRules operation middleware
+func (sh *strictHandler) SetTeamReviewRules(w http.ResponseWriter, r *http.Request) {
+	var request SetTeamReviewRulesRequestObject
+
+	var body SetTeamReviewRulesJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SetTeamReviewRules(ctx, request.(SetTeamReviewRulesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetTeamReviewRules")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SetTeamReviewRulesResponseObject); ok {
+		if err := validResponse.VisitSetTeamReviewRulesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SetTeamWorkingHoursPolicy operation middleware
+func (sh *strictHandler) SetTeamWorkingHoursPolicy(w http.ResponseWriter, r *http.Request) {
+	var request SetTeamWorkingHoursPolicyRequestObject
+
+	var body SetTeamWorkingHoursPolicyJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SetTeamWorkingHoursPolicy(ctx, request.(SetTeamWorkingHoursPolicyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetTeamWorkingHoursPolicy")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SetTeamWorkingHoursPolicyResponseObject); ok {
+		if err := validResponse.VisitSetTeamWorkingHoursPolicyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AddUserOutOfOffice operation middleware
+func (sh *strictHandler) AddUserOutOfOffice(w http.ResponseWriter, r *http.Request) {
+	var request AddUserOutOfOfficeRequestObject
+
+	var body AddUserOutOfOfficeJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AddUserOutOfOffice(ctx, request.(AddUserOutOfOfficeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AddUserOutOfOffice")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AddUserOutOfOfficeResponseObject); ok {
+		if err := validResponse.VisitAddUserOutOfOfficeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteUserOutOfOffice operation middleware
+func (sh *strictHandler) DeleteUserOutOfOffice(w http.ResponseWriter, r *http.Request) {
+	var request DeleteUserOutOfOfficeRequestObject
+
+	var body DeleteUserOutOfOfficeJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteUserOutOfOffice(ctx, request.(DeleteUserOutOfOfficeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteUserOutOfOffice")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteUserOutOfOfficeResponseObject); ok {
+		if err := validResponse.VisitDeleteUserOutOfOfficeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteUserWorkingHours operation middleware
+func (sh *strictHandler) DeleteUserWorkingHours(w http.ResponseWriter, r *http.Request) {
+	var request DeleteUserWorkingHoursRequestObject
+
+	var body DeleteUserWorkingHoursJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteUserWorkingHours(ctx, request.(DeleteUserWorkingHoursRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteUserWorkingHours")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteUserWorkingHoursResponseObject); ok {
+		if err := validResponse.VisitDeleteUserWorkingHoursResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetUserOutOfOffice operation middleware
+func (sh *strictHandler) GetUserOutOfOffice(w http.ResponseWriter, r *http.Request, params GetUserOutOfOfficeParams) {
+	var request GetUserOutOfOfficeRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetUserOutOfOffice(ctx, request.(GetUserOutOfOfficeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetUserOutOfOffice")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetUserOutOfOfficeResponseObject); ok {
+		if err := validResponse.VisitGetUserOutOfOfficeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetUserReviews operation middleware
+func (sh *strictHandler) GetUserReviews(w http.ResponseWriter, r *http.Request, params GetUserReviewsParams) {
+	var request GetUserReviewsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetUserReviews(ctx, request.(GetUserReviewsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetUserReviews")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetUserReviewsResponseObject); ok {
+		if err := validResponse.VisitGetUserReviewsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetUserSkills operation middleware
+func (sh *strictHandler) GetUserSkills(w http.ResponseWriter, r *http.Request, params GetUserSkillsParams) {
+	var request GetUserSkillsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetUserSkills(ctx, request.(GetUserSkillsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetUserSkills")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetUserSkillsResponseObject); ok {
+		if err := validResponse.VisitGetUserSkillsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetUserWorkingHours operation middleware
+func (sh *strictHandler) GetUserWorkingHours(w http.ResponseWriter, r *http.Request, params GetUserWorkingHoursParams) {
+	var request GetUserWorkingHoursRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetUserWorkingHours(ctx, request.(GetUserWorkingHoursRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetUserWorkingHours")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetUserWorkingHoursResponseObject); ok {
+		if err := validResponse.VisitGetUserWorkingHoursResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SetUserIsActive operation middleware
+func (sh *strictHandler) SetUserIsActive(w http.ResponseWriter, r *http.Request, params SetUserIsActiveParams) {
+	var request SetUserIsActiveRequestObject
+
+	request.Params = params
+
+	var body SetUserIsActiveJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SetUserIsActive(ctx, request.(SetUserIsActiveRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetUserIsActive")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SetUserIsActiveResponseObject); ok {
+		if err := validResponse.VisitSetUserIsActiveResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SetUserMaxOpenReviews operation middleware
+func (sh *strictHandler) SetUserMaxOpenReviews(w http.ResponseWriter, r *http.Request, params SetUserMaxOpenReviewsParams) {
+	var request SetUserMaxOpenReviewsRequestObject
+
+	request.Params = params
+
+	var body SetUserMaxOpenReviewsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SetUserMaxOpenReviews(ctx, request.(SetUserMaxOpenReviewsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetUserMaxOpenReviews")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SetUserMaxOpenReviewsResponseObject); ok {
+		if err := validResponse.VisitSetUserMaxOpenReviewsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SetUserSkills operation middleware
+func (sh *strictHandler) SetUserSkills(w http.ResponseWriter, r *http.Request, params SetUserSkillsParams) {
+	var request SetUserSkillsRequestObject
+
+	request.Params = params
+
+	var body SetUserSkillsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SetUserSkills(ctx, request.(SetUserSkillsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetUserSkills")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SetUserSkillsResponseObject); ok {
+		if err := validResponse.VisitSetUserSkillsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SetUserWorkingHours operation middleware
+func (sh *strictHandler) SetUserWorkingHours(w http.ResponseWriter, r *http.Request) {
+	var request SetUserWorkingHoursRequestObject
+
+	var body SetUserWorkingHoursJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SetUserWorkingHours(ctx, request.(SetUserWorkingHoursRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetUserWorkingHours")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SetUserWorkingHoursResponseObject); ok {
+		if err := validResponse.VisitSetUserWorkingHoursResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetWebhookDeliveries operation middleware
+func (sh *strictHandler) GetWebhookDeliveries(w http.ResponseWriter, r *http.Request, params GetWebhookDeliveriesParams) {
+	var request GetWebhookDeliveriesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetWebhookDeliveries(ctx, request.(GetWebhookDeliveriesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetWebhookDeliveries")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetWebhookDeliveriesResponseObject); ok {
+		if err := validResponse.VisitGetWebhookDeliveriesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SubscribeWebhook operation middleware
+func (sh *strictHandler) SubscribeWebhook(w http.ResponseWriter, r *http.Request) {
+	var request SubscribeWebhookRequestObject
+
+	var body SubscribeWebhookJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SubscribeWebhook(ctx, request.(SubscribeWebhookRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SubscribeWebhook")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SubscribeWebhookResponseObject); ok {
+		if err := validResponse.VisitSubscribeWebhookResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UnsubscribeWebhook operation middleware
+func (sh *strictHandler) UnsubscribeWebhook(w http.ResponseWriter, r *http.Request) {
+	var request UnsubscribeWebhookRequestObject
+
+	var body UnsubscribeWebhookJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UnsubscribeWebhook(ctx, request.(UnsubscribeWebhookRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UnsubscribeWebhook")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UnsubscribeWebhookResponseObject); ok {
+		if err := validResponse.VisitUnsubscribeWebhookResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
@@ -0,0 +1,10 @@
+// Package api содержит OpenAPI-спецификацию сервиса, встроенную в бинарник,
+// и сгенерированные по ней модели и strict-интерфейс HTTP-сервера.
+package api
+
+import _ "embed"
+
+//go:generate oapi-codegen --config=oapi-codegen.yml openapi.yml
+
+//go:embed openapi.yml
+var Spec []byte
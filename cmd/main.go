@@ -2,25 +2,53 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"log"
 	"log/slog"
 	"net/http"
 	"os"
+	"os/signal"
+	"strings"
+	"syscall"
 	"time"
+	// Рабочие часы пользователей задаются в часовых поясах IANA, а в образе нет tzdata.
+	_ "time/tzdata"
 
+	"github.com/LeoUraltsev/PRReviewerService/api"
+	"github.com/LeoUraltsev/PRReviewerService/internal/client/github"
+	"github.com/LeoUraltsev/PRReviewerService/internal/clock"
 	"github.com/LeoUraltsev/PRReviewerService/internal/config"
+	"github.com/LeoUraltsev/PRReviewerService/internal/domain"
+	"github.com/LeoUraltsev/PRReviewerService/internal/http/handler"
+	ih "github.com/LeoUraltsev/PRReviewerService/internal/http/handler/integration"
+	oh "github.com/LeoUraltsev/PRReviewerService/internal/http/handler/out_of_office"
 	"github.com/LeoUraltsev/PRReviewerService/internal/http/handler/pull_request"
+	rh "github.com/LeoUraltsev/PRReviewerService/internal/http/handler/repository"
 	th "github.com/LeoUraltsev/PRReviewerService/internal/http/handler/team"
 	uh "github.com/LeoUraltsev/PRReviewerService/internal/http/handler/user"
+	wh "github.com/LeoUraltsev/PRReviewerService/internal/http/handler/webhook"
 	appmw "github.com/LeoUraltsev/PRReviewerService/internal/http/middleware"
+	fs "github.com/LeoUraltsev/PRReviewerService/internal/service/forge"
+	is "github.com/LeoUraltsev/PRReviewerService/internal/service/ingest"
+	oos "github.com/LeoUraltsev/PRReviewerService/internal/service/out_of_office"
+	outboxService "github.com/LeoUraltsev/PRReviewerService/internal/service/outbox"
 	pr "github.com/LeoUraltsev/PRReviewerService/internal/service/pull_request"
+	rs "github.com/LeoUraltsev/PRReviewerService/internal/service/repository"
 	ts "github.com/LeoUraltsev/PRReviewerService/internal/service/team"
 	us "github.com/LeoUraltsev/PRReviewerService/internal/service/user"
+	ws "github.com/LeoUraltsev/PRReviewerService/internal/service/webhook"
 	"github.com/LeoUraltsev/PRReviewerService/internal/storage/pg"
+	forgeStorage "github.com/LeoUraltsev/PRReviewerService/internal/storage/pg/forge"
+	oooStorage "github.com/LeoUraltsev/PRReviewerService/internal/storage/pg/out_of_office"
+	outboxStorage "github.com/LeoUraltsev/PRReviewerService/internal/storage/pg/outbox"
 	pullStorage "github.com/LeoUraltsev/PRReviewerService/internal/storage/pg/pull_request"
+	repositoryStorage "github.com/LeoUraltsev/PRReviewerService/internal/storage/pg/repository"
 	teamStorage "github.com/LeoUraltsev/PRReviewerService/internal/storage/pg/team"
 	userStorage "github.com/LeoUraltsev/PRReviewerService/internal/storage/pg/user"
+	webhookStorage "github.com/LeoUraltsev/PRReviewerService/internal/storage/pg/webhook"
+	"github.com/LeoUraltsev/PRReviewerService/internal/tracing"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
 )
@@ -36,7 +64,15 @@ func main() {
 		Level: cfg.LogLever,
 	}))
 
-	ctx := context.Background()
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	shutdownTracing, err := tracing.NewProvider(ctx, cfg)
+	if err != nil {
+		fmt.Printf("Stopping application: %v\n", err)
+		os.Exit(1)
+	}
+	defer shutdownTracing(context.Background())
 
 	s, err := pg.NewStorage(ctx, log, cfg)
 	defer s.Close(ctx)
@@ -45,36 +81,75 @@ func main() {
 		os.Exit(1)
 	}
 
+	validator, err := appmw.NewOpenAPIValidator(log, api.Spec, cfg.OpenAPIStrict)
+	if err != nil {
+		fmt.Printf("Stopping application: %v\n", err)
+		os.Exit(1)
+	}
+
 	r := chi.NewRouter()
 	r.Use(middleware.RequestID)
+	r.Use(appmw.Tracing)
 	r.Use(middleware.Logger)
 	r.Use(appmw.ContentTypeApplicationJson)
+	r.Use(validator.Validate)
 
 	prStorage := pullStorage.NewStorage(log, s.Pool)
 	tStorage := teamStorage.NewStorage(log, s.Pool)
 	uStorage := userStorage.NewStorage(log, s.Pool)
+	wStorage := webhookStorage.NewStorage(log, s.Pool)
+
+	oStorage := outboxStorage.NewStorage(log, s.Pool)
+	fStorage := forgeStorage.NewStorage(log, s.Pool)
+	rStorage := repositoryStorage.NewStorage(log, s.Pool)
+	ooStorage := oooStorage.NewStorage(log, s.Pool)
+
+	dispatcher := ws.NewDispatcher(log, wStorage, &http.Client{Timeout: cfg.WebhookTimeout})
+
+	forgeClients := map[domain.ForgeProvider]fs.ForgeClient{}
+	if cfg.GitHubToken != "" {
+		forgeClients[domain.ForgeGitHub] = github.NewClient(cfg.GitHubAPIURL, cfg.GitHubToken, &http.Client{Timeout: cfg.WebhookTimeout})
+	}
+	forgeSink := fs.NewSink(log, fStorage, forgeClients)
+
+	sinks, closeSinks, err := newOutboxSinks(cfg, dispatcher, forgeSink)
+	if err != nil {
+		fmt.Printf("Stopping application: %v\n", err)
+		os.Exit(1)
+	}
+	defer closeSinks()
+
+	relay := outboxService.NewRelay(log, oStorage, sinks, outboxService.Options{
+		PollInterval: cfg.OutboxPollInterval,
+		BatchSize:    cfg.OutboxBatchSize,
+		MaxAttempts:  cfg.OutboxMaxAttempts,
+		Backoff:      cfg.OutboxBackoff,
+		LeaseTimeout: cfg.OutboxLeaseTimeout,
+	})
+	go relay.Run(ctx)
 
 	teamService := ts.NewService(uStorage, tStorage)
 	userService := us.NewService(prStorage, uStorage)
-	prService := pr.NewService(prStorage, uStorage)
+	prService := pr.NewService(prStorage, uStorage, rStorage, tStorage, clock.Real{})
+	repositoryService := rs.NewService(rStorage, rStorage)
+	webhookService := ws.NewService(wStorage)
+	ingestService := is.NewService(fStorage, prService)
+	outOfOfficeService := oos.NewService(ooStorage, uStorage, clock.Real{})
 
-	teamHandler := th.NewHandler(teamService, teamService)
-	userHandler := uh.NewHandler(userService, userService)
-	prHandler := pull_request.NewHandler(prService, prService)
+	scheduler := oos.NewScheduler(log, ooStorage, prStorage, prService, clock.Real{}, cfg.OutOfOfficePollInterval)
+	go scheduler.Run(ctx)
 
-	r.Route("/team", func(r chi.Router) {
-		r.Post("/add", teamHandler.AddingTeam)
-		r.Get("/get", teamHandler.GetTeam)
-	})
-	r.Route("/users", func(r chi.Router) {
-		r.Post("/setIsActive", userHandler.SetIsActive)
-		r.Get("/getReview", userHandler.GetReview)
-	})
-	r.Route("/pullRequest", func(r chi.Router) {
-		r.Post("/create", prHandler.CreatePullRequest)
-		r.Post("/merge", prHandler.MergePullRequest)
-		r.Post("/reassign", prHandler.ReassignPullRequest)
-	})
+	teamHandler := th.NewHandler(teamService, teamService, teamService, teamService, teamService, teamService)
+	userHandler := uh.NewHandler(userService, userService, userService, userService, userService)
+	prHandler := pull_request.NewHandler(prService, prService, prService, prService, cfg.AdminToken)
+	webhookHandler := wh.NewHandler(webhookService, webhookService)
+	integrationHandler := ih.NewHandler(ingestService)
+	repositoryHandler := rh.NewHandler(repositoryService, repositoryService, repositoryService)
+	outOfOfficeHandler := oh.NewHandler(outOfOfficeService)
+
+	r.Method(http.MethodPost, "/integrations/github/webhook", ih.NewGitHubHandler(log, ingestService, cfg.GitHubWebhookSecret))
+	r.Method(http.MethodPost, "/integrations/gitlab/webhook", ih.NewGitLabHandler(log, ingestService, cfg.GitLabWebhookToken))
+	handler.Register(log, r, handler.NewServer(teamHandler, userHandler, prHandler, webhookHandler, integrationHandler, repositoryHandler, outOfOfficeHandler))
 
 	server := http.Server{
 		Addr:              fmt.Sprintf("%s:%s", cfg.Host, cfg.Port),
@@ -84,10 +159,60 @@ func main() {
 		WriteTimeout:      cfg.WriteTimeout * time.Second,
 		IdleTimeout:       cfg.IdleTimeout * time.Second,
 	}
+	go func() {
+		<-ctx.Done()
+		_ = server.Shutdown(context.Background())
+	}()
+
 	log.Info("starting server")
 
-	if err = server.ListenAndServe(); err != nil {
+	if err = server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
 		slog.Error("failed to start server", slog.String("error", err.Error()))
 	}
 
 }
+
+// newOutboxSinks собирает получателей событий outbox по списку OUTBOX_SINKS.
+func newOutboxSinks(cfg *config.Config, dispatcher *ws.Dispatcher, forgeSink *fs.Sink) ([]outboxService.Sink, func(), error) {
+	var (
+		sinks   []outboxService.Sink
+		closers []io.Closer
+	)
+	closeAll := func() {
+		for _, c := range closers {
+			_ = c.Close()
+		}
+	}
+
+	for _, name := range cfg.OutboxSinks {
+		switch strings.TrimSpace(name) {
+		case "webhook":
+			sinks = append(sinks, dispatcher)
+		case "forge":
+			sinks = append(sinks, forgeSink)
+		case "stdout":
+			sinks = append(sinks, outboxService.NewStdoutSink())
+		case "file":
+			sink, closer, err := outboxService.NewFileSink(cfg.OutboxFilePath)
+			if err != nil {
+				closeAll()
+				return nil, nil, err
+			}
+			sinks = append(sinks, sink)
+			closers = append(closers, closer)
+		case "nats":
+			sink, err := outboxService.NewNATSSink(cfg.OutboxNATSURL, cfg.OutboxNATSSubjectPrefix, cfg.WebhookTimeout)
+			if err != nil {
+				closeAll()
+				return nil, nil, err
+			}
+			sinks = append(sinks, sink)
+			closers = append(closers, sink)
+		case "":
+		default:
+			closeAll()
+			return nil, nil, fmt.Errorf("unknown outbox sink %q", name)
+		}
+	}
+	return sinks, closeAll, nil
+}
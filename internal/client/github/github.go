@@ -0,0 +1,85 @@
+package github
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/LeoUraltsev/PRReviewerService/internal/domain"
+)
+
+const DefaultBaseURL = "https://api.github.com"
+
+// Client — минимальный клиент GitHub REST API для управления ревьюверами и комментариями PR.
+type Client struct {
+	baseURL string
+	token   string
+	http    *http.Client
+}
+
+func NewClient(baseURL string, token string, client *http.Client) *Client {
+	if baseURL == "" {
+		baseURL = DefaultBaseURL
+	}
+	return &Client{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		token:   token,
+		http:    client,
+	}
+}
+
+type reviewersRequest struct {
+	Reviewers []string `json:"reviewers"`
+}
+
+type commentRequest struct {
+	Body string `json:"body"`
+}
+
+func (c *Client) RequestReviewers(ctx context.Context, pr *domain.ForgePullRequest, logins []string) error {
+	path := fmt.Sprintf("/repos/%s/pulls/%d/requested_reviewers", pr.Repository, pr.Number)
+	return c.do(ctx, http.MethodPost, path, reviewersRequest{Reviewers: logins})
+}
+
+func (c *Client) RemoveReviewer(ctx context.Context, pr *domain.ForgePullRequest, login string) error {
+	path := fmt.Sprintf("/repos/%s/pulls/%d/requested_reviewers", pr.Repository, pr.Number)
+	return c.do(ctx, http.MethodDelete, path, reviewersRequest{Reviewers: []string{login}})
+}
+
+func (c *Client) PostComment(ctx context.Context, pr *domain.ForgePullRequest, body string) error {
+	path := fmt.Sprintf("/repos/%s/issues/%d/comments", pr.Repository, pr.Number)
+	return c.do(ctx, http.MethodPost, path, commentRequest{Body: body})
+}
+
+func (c *Client) do(ctx context.Context, method string, path string, body any) error {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-GitHub-Api-Version", "2022-11-28")
+	req.Header.Set("Authorization", "Bearer "+c.token)
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1<<10))
+		return fmt.Errorf("github %s %s: status %d: %s", method, path, resp.StatusCode, bytes.TrimSpace(msg))
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	return nil
+}
@@ -0,0 +1,87 @@
+// Package githubtest содержит поддельный сервер GitHub REST API для тестов без сети.
+package githubtest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+)
+
+// Call — запрос, принятый поддельным сервером.
+type Call struct {
+	Method    string
+	Path      string
+	Token     string
+	Reviewers []string
+	Body      string
+}
+
+// Server отвечает 201/200 на запросы ревьюверов и комментариев и запоминает их.
+// Пока FailNext > 0, запросы завершаются ошибкой 502.
+type Server struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	calls    []Call
+	failNext int
+}
+
+func NewServer() *Server {
+	s := &Server{}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	return s
+}
+
+// FailNext заставляет следующие n запросов вернуть 502.
+func (s *Server) FailNext(n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failNext = n
+}
+
+func (s *Server) Calls() []Call {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Call(nil), s.calls...)
+}
+
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	var body struct {
+		Reviewers []string `json:"reviewers"`
+		Body      string   `json:"body"`
+	}
+	_ = json.NewDecoder(r.Body).Decode(&body)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.failNext > 0 {
+		s.failNext--
+		http.Error(w, `{"message":"Bad Gateway"}`, http.StatusBadGateway)
+		return
+	}
+
+	switch {
+	case strings.HasSuffix(r.URL.Path, "/requested_reviewers") && (r.Method == http.MethodPost || r.Method == http.MethodDelete),
+		strings.HasSuffix(r.URL.Path, "/comments") && r.Method == http.MethodPost:
+	default:
+		http.Error(w, `{"message":"Not Found"}`, http.StatusNotFound)
+		return
+	}
+
+	s.calls = append(s.calls, Call{
+		Method:    r.Method,
+		Path:      r.URL.Path,
+		Token:     strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer "),
+		Reviewers: body.Reviewers,
+		Body:      body.Body,
+	})
+
+	w.Header().Set("Content-Type", "application/json")
+	if r.Method == http.MethodPost {
+		w.WriteHeader(http.StatusCreated)
+	}
+	_, _ = w.Write([]byte(`{}`))
+}
@@ -0,0 +1,27 @@
+package domain
+
+import "time"
+
+// MaxAuditReasonLength ограничивает длину причины, записываемой в журнал аудита.
+const MaxAuditReasonLength = 500
+
+var ErrDeclineReasonRequired = NewError(CodeIncorrectData, "reason is required to decline a review")
+
+type AuditAction string
+
+const (
+	AuditMergeOverride  AuditAction = "merge_override"
+	AuditReviewDeclined AuditAction = "review_declined"
+)
+
+// AuditEntry — запись журнала аудита PR. Details для merge_override — условия политики,
+// которые не были выполнены в момент merge.
+type AuditEntry struct {
+	ID            int64
+	PullRequestID string
+	Action        AuditAction
+	Actor         string
+	Reason        string
+	Details       []ErrorDetail
+	CreatedAt     time.Time
+}
@@ -0,0 +1,39 @@
+package domain
+
+const (
+	DefaultMaxOpenReviews = 3
+	MaxOpenReviewsLimit   = 50
+)
+
+// ReviewerCapacity — загрузка ревьювера: открытые PR, где он назначен, и его лимит.
+// Лимит берётся из настроек пользователя, а если он не задан — из настроек команды.
+type ReviewerCapacity struct {
+	UserID         string
+	Username       string
+	IsActive       bool
+	OpenReviews    int
+	MaxOpenReviews int
+	// CustomLimit — лимит задан пользователю явно, а не унаследован от команды.
+	CustomLimit bool
+	// Version — версия пользователя (см. User.Version).
+	Version int64
+}
+
+// Remaining — сколько ещё ревью можно назначить пользователю.
+func (c *ReviewerCapacity) Remaining() int {
+	return max(0, c.MaxOpenReviews-c.OpenReviews)
+}
+
+// TeamCapacity — лимит команды по умолчанию и загрузка её участников.
+type TeamCapacity struct {
+	TeamName       string
+	MaxOpenReviews int
+	Members        []*ReviewerCapacity
+}
+
+func ValidateMaxOpenReviews(limit int) error {
+	if limit < 1 || limit > MaxOpenReviewsLimit {
+		return NewError(CodeIncorrectData, "max_open_reviews must be between 1 and 50")
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+var ErrCodeOwnersNotFound = NewError(CodeNotFound, "CODEOWNERS for repository not found")
+
+// CodeOwnersFile — содержимое CODEOWNERS, зарегистрированное для репозитория.
+type CodeOwnersFile struct {
+	RepositoryID string
+	Content      string
+	UpdatedAt    time.Time
+}
+
+// CodeOwnersRule — строка CODEOWNERS: шаблон пути и владельцы (@user, @org/team или email).
+type CodeOwnersRule struct {
+	Line    int
+	Pattern string
+	Owners  []string
+	re      *regexp.Regexp
+}
+
+// CodeOwners — разобранный файл CODEOWNERS. Как и в GitHub, для пути действует
+// последнее подходящее правило.
+type CodeOwners struct {
+	Rules []*CodeOwnersRule
+}
+
+func ParseCodeOwners(content string) (*CodeOwners, error) {
+	co := &CodeOwners{}
+	for i, line := range strings.Split(content, "\n") {
+		lineNo := i + 1
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if idx := strings.Index(line, " #"); idx >= 0 {
+			line = strings.TrimSpace(line[:idx])
+		}
+
+		fields := strings.Fields(line)
+		pattern := strings.TrimPrefix(fields[0], `\`)
+		if strings.HasPrefix(fields[0], "!") || strings.ContainsAny(pattern, "[]") {
+			return nil, invalidCodeOwners(lineNo, "negation and character ranges are not supported")
+		}
+		for _, owner := range fields[1:] {
+			if !strings.Contains(owner, "@") {
+				return nil, invalidCodeOwners(lineNo, fmt.Sprintf("owner %q must be @user, @org/team or email", owner))
+			}
+		}
+
+		re, err := regexp.Compile(patternToRegexp(pattern))
+		if err != nil {
+			return nil, invalidCodeOwners(lineNo, err.Error())
+		}
+		co.Rules = append(co.Rules, &CodeOwnersRule{
+			Line:    lineNo,
+			Pattern: pattern,
+			Owners:  fields[1:],
+			re:      re,
+		})
+	}
+	return co, nil
+}
+
+// Match возвращает правило, действующее для пути, или nil.
+func (c *CodeOwners) Match(path string) *CodeOwnersRule {
+	path = strings.TrimPrefix(path, "/")
+	for i := len(c.Rules) - 1; i >= 0; i-- {
+		if c.Rules[i].re.MatchString(path) {
+			return c.Rules[i]
+		}
+	}
+	return nil
+}
+
+// ParseOwner разделяет владельца на пользователя и команду: "@alice" — пользователь alice,
+// "@org/backend" — команда backend. Владельцы-email сервису неизвестны и пропускаются.
+func ParseOwner(owner string) (user string, team string, ok bool) {
+	handle, found := strings.CutPrefix(owner, "@")
+	if !found || handle == "" {
+		return "", "", false
+	}
+	if _, teamName, isTeam := strings.Cut(handle, "/"); isTeam {
+		return "", teamName, teamName != ""
+	}
+	return handle, "", true
+}
+
+// patternToRegexp переводит шаблон в стиле gitignore в регулярное выражение.
+// Шаблон со слешем в начале или в середине привязан к корню, без слеша — совпадает на любой глубине.
+// Совпадение с каталогом распространяется на всё его содержимое, кроме шаблонов вида "dir/*".
+func patternToRegexp(pattern string) string {
+	anchored := strings.HasPrefix(pattern, "/") || strings.Contains(strings.Trim(pattern, "/"), "/")
+	pattern = strings.Trim(pattern, "/")
+
+	var b strings.Builder
+	b.WriteString("^")
+	if !anchored {
+		b.WriteString("(.*/)?")
+	}
+	for i := 0; i < len(pattern); {
+		switch {
+		case strings.HasPrefix(pattern[i:], "**/"):
+			b.WriteString("(.*/)?")
+			i += 3
+		case strings.HasPrefix(pattern[i:], "**"):
+			b.WriteString(".*")
+			i += 2
+		case pattern[i] == '*':
+			b.WriteString("[^/]*")
+			i++
+		case pattern[i] == '?':
+			b.WriteString("[^/]")
+			i++
+		default:
+			b.WriteString(regexp.QuoteMeta(pattern[i : i+1]))
+			i++
+		}
+	}
+	if !strings.HasSuffix(pattern, "/*") {
+		b.WriteString("(/.*)?")
+	}
+	b.WriteString("$")
+	return b.String()
+}
+
+func invalidCodeOwners(line int, reason string) error {
+	return NewError(CodeIncorrectData, fmt.Sprintf("invalid CODEOWNERS line %d: %s", line, reason))
+}
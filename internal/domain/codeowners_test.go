@@ -0,0 +1,78 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testCodeOwners = `# Владельцы по умолчанию
+*                 @acme/backend
+*.sql             @dba-dan
+/docs/            @writer
+apps/             @apps-owner
+/build/logs/      @ops
+docs/*            @docs-lead
+**/migrations     @dba-dan @acme/platform
+/scripts/*.sh     devops@example.com # только shell-скрипты
+/vendor/
+`
+
+func TestCodeOwners_Match(t *testing.T) {
+	co, err := ParseCodeOwners(testCodeOwners)
+	require.NoError(t, err)
+
+	tests := []struct {
+		path     string
+		wantLine int
+	}{
+		{"main.go", 2},
+		{"internal/storage/query.sql", 3},
+		{"docs/index.md", 7},
+		{"docs/guides/setup.md", 4},
+		{"service/docs/index.md", 2},
+		{"apps/web/main.js", 5},
+		{"services/apps/main.go", 5},
+		{"build/logs/today.txt", 6},
+		{"migrations/00001_teams.sql", 8},
+		{"db/migrations/00001_teams.sql", 8},
+		{"scripts/deploy.sh", 9},
+		{"scripts/ci/deploy.sh", 2},
+		{"vendor/lib/lib.go", 10},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			rule := co.Match(tt.path)
+			require.NotNil(t, rule)
+			assert.Equal(t, tt.wantLine, rule.Line)
+		})
+	}
+
+	assert.Empty(t, co.Match("vendor/lib/lib.go").Owners)
+	assert.Equal(t, []string{"@dba-dan", "@acme/platform"}, co.Match("db/migrations/1.sql").Owners)
+}
+
+func TestParseCodeOwners_Invalid(t *testing.T) {
+	_, err := ParseCodeOwners("*.go @backend\n!vendor/ @nobody\n")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "line 2")
+
+	_, err = ParseCodeOwners("*.go backend\n")
+	require.Error(t, err)
+}
+
+func TestParseOwner(t *testing.T) {
+	user, team, ok := ParseOwner("@alice")
+	assert.True(t, ok)
+	assert.Equal(t, "alice", user)
+	assert.Empty(t, team)
+
+	user, team, ok = ParseOwner("@acme/backend")
+	assert.True(t, ok)
+	assert.Empty(t, user)
+	assert.Equal(t, "backend", team)
+
+	_, _, ok = ParseOwner("devops@example.com")
+	assert.False(t, ok)
+}
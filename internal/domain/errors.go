@@ -0,0 +1,48 @@
+package domain
+
+// ErrorCode — машиночитаемый код доменной ошибки, совпадает с ErrorCode из api/openapi.yml.
+type ErrorCode string
+
+const (
+	CodeNotFound            ErrorCode = "NOT_FOUND"
+	CodeTeamExists          ErrorCode = "TEAM_EXISTS"
+	CodeRepositoryExists    ErrorCode = "REPOSITORY_EXISTS"
+	CodePRExists            ErrorCode = "PR_EXISTS"
+	CodePRMerged            ErrorCode = "PR_MERGED"
+	CodeNotAssigned         ErrorCode = "NOT_ASSIGNED"
+	CodeAlreadyAssigned     ErrorCode = "ALREADY_ASSIGNED"
+	CodeReviewerNotEligible ErrorCode = "REVIEWER_NOT_ELIGIBLE"
+	CodeNoCandidate         ErrorCode = "NO_CANDIDATE"
+	CodeMergeBlocked        ErrorCode = "MERGE_BLOCKED"
+	CodePRNotOpen           ErrorCode = "PR_NOT_OPEN"
+	CodeInvalidTransition   ErrorCode = "INVALID_TRANSITION"
+	CodeIncorrectData       ErrorCode = "INCORRECT_DATA"
+	CodeUnauthorized        ErrorCode = "UNAUTHORIZED"
+	CodeVersionMismatch     ErrorCode = "VERSION_MISMATCH"
+	CodeConcurrentUpdate    ErrorCode = "CONCURRENT_UPDATE"
+)
+
+// Error — доменная ошибка с кодом. Все ожидаемые отказы сервисов описываются
+// значениями этого типа, всё остальное считается внутренней ошибкой.
+type Error struct {
+	Code    ErrorCode
+	Message string
+	// Details уточняет ошибку по отдельным полям или условиям, например для MERGE_BLOCKED.
+	Details []ErrorDetail
+}
+
+type ErrorDetail struct {
+	Field   string
+	Message string
+}
+
+func NewError(code ErrorCode, message string) *Error {
+	return &Error{
+		Code:    code,
+		Message: message,
+	}
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
@@ -0,0 +1,58 @@
+package domain
+
+import (
+	"crypto/rand"
+	"time"
+)
+
+type EventType string
+
+const (
+	EventPRCreated          EventType = "pr.created"
+	EventReviewerAssigned   EventType = "reviewer.assigned"
+	EventReviewerReassigned EventType = "reviewer.reassigned"
+	EventReviewerRemoved    EventType = "reviewer.removed"
+	EventPRMerged           EventType = "pr.merged"
+	EventPRReady            EventType = "pr.ready"
+	EventPRClosed           EventType = "pr.closed"
+	EventPRReopened         EventType = "pr.reopened"
+	EventPRUpdated          EventType = "pr.updated"
+	EventPRUnderstaffed     EventType = "pr.understaffed"
+	EventReviewSubmitted    EventType = "review.submitted"
+)
+
+// Event — факт изменения PR или состава его ревьюверов, о котором уведомляются подписчики.
+// PullRequest содержит снимок PR после изменения и заполняется хранилищем
+// в той же транзакции, в которой событие записывается в outbox.
+type Event struct {
+	ID            string
+	Type          EventType
+	OccurredAt    time.Time
+	PullRequest   *PullRequest
+	ReviewerID    string
+	OldReviewerID string
+	// Verdict заполняется для EventReviewSubmitted.
+	Verdict Verdict
+}
+
+// OutboxMessage — событие, ожидающее публикации из outbox.
+type OutboxMessage struct {
+	ID          int64
+	AggregateID string
+	Attempts    int
+	Event       Event
+	// Delivered — получатели, которые уже получили событие при прошлых попытках.
+	Delivered []string
+}
+
+func NewEvent(eventType EventType) Event {
+	return Event{
+		ID:         rand.Text(),
+		Type:       eventType,
+		OccurredAt: time.Now().UTC(),
+	}
+}
+
+func (t EventType) String() string {
+	return string(t)
+}
@@ -0,0 +1,80 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+var (
+	ErrForgeSignature       = NewError(CodeUnauthorized, "invalid webhook signature")
+	ErrForgeAccountNotFound = NewError(CodeNotFound, "forge account is not linked to a user")
+	ErrForgePRNotFound      = NewError(CodeNotFound, "forge pull request is not tracked")
+)
+
+// ForgeProvider — хостинг репозиториев, из которого приходят события PR.
+type ForgeProvider string
+
+const (
+	ForgeGitHub ForgeProvider = "github"
+	ForgeGitLab ForgeProvider = "gitlab"
+)
+
+func (p ForgeProvider) String() string {
+	return string(p)
+}
+
+// ForgeAction — действие с PR на стороне форжа, приведённое к общему для всех провайдеров виду.
+type ForgeAction string
+
+const (
+	ForgeActionOpened   ForgeAction = "opened"
+	ForgeActionReopened ForgeAction = "reopened"
+	ForgeActionUpdated  ForgeAction = "updated"
+	ForgeActionMerged   ForgeAction = "merged"
+	ForgeActionClosed   ForgeAction = "closed"
+)
+
+// ForgePullRequestEvent — событие о PR/MR из внешнего форжа.
+type ForgePullRequestEvent struct {
+	Provider   ForgeProvider
+	Repository string
+	Number     int64
+	Title      string
+	// AuthorLogin — логин автора PR во форже; пустой, если из события автора не определить.
+	AuthorLogin string
+	Action      ForgeAction
+	// Size — объём изменений PR; nil, если форж его не передаёт.
+	Size *PullRequestSize
+}
+
+// PullRequestID возвращает идентификатор, под которым PR из форжа хранится в сервисе.
+func (e *ForgePullRequestEvent) PullRequestID() string {
+	return fmt.Sprintf("%s:%s#%d", e.Provider, e.Repository, e.Number)
+}
+
+// ForgePullRequest связывает PR сервиса с PR во внешнем форже.
+type ForgePullRequest struct {
+	Provider      ForgeProvider
+	Repository    string
+	Number        int64
+	PullRequestID string
+}
+
+type ForgeAccount struct {
+	Provider ForgeProvider
+	Login    string
+	UserID   string
+}
+
+type ForgeRepository struct {
+	Provider  ForgeProvider
+	FullName  string
+	CreatedAt time.Time
+}
+
+// IngestResult — итог обработки события форжа: либо вызван сервис PR, либо событие пропущено с причиной.
+type IngestResult struct {
+	Processed     bool
+	PullRequestID string
+	Reason        string
+}
@@ -0,0 +1,77 @@
+package domain
+
+import "fmt"
+
+var ErrOverrideReasonRequired = NewError(CodeIncorrectData, "reason is required to override merge policy")
+
+// MergePolicy — условия, при которых PR можно смержить. Политика задаётся команде и репозиторию;
+// к PR применяется более строгая из политик команды автора и репозитория PR.
+// Нулевое значение не ограничивает merge.
+type MergePolicy struct {
+	// RequiredApprovals — сколько ревьюверов должны одобрить PR.
+	RequiredApprovals int
+	// BlockOnChangesRequested запрещает merge, пока кто-то из ревьюверов запрашивает изменения.
+	BlockOnChangesRequested bool
+	// RequireFullStaff запрещает merge PR, которому не хватило ревьюверов.
+	RequireFullStaff bool
+}
+
+func (p MergePolicy) Validate() error {
+	if p.RequiredApprovals < 0 || p.RequiredApprovals > MaxReviewersCount {
+		return NewError(CodeIncorrectData, fmt.Sprintf("required_approvals must be between 0 and %d", MaxReviewersCount))
+	}
+	return nil
+}
+
+// Strictest объединяет две политики, беря более строгое значение каждого условия.
+func (p MergePolicy) Strictest(other MergePolicy) MergePolicy {
+	return MergePolicy{
+		RequiredApprovals:       max(p.RequiredApprovals, other.RequiredApprovals),
+		BlockOnChangesRequested: p.BlockOnChangesRequested || other.BlockOnChangesRequested,
+		RequireFullStaff:        p.RequireFullStaff || other.RequireFullStaff,
+	}
+}
+
+// Unmet возвращает условия политики, которые PR не выполняет; пустой результат — merge разрешён.
+func (p MergePolicy) Unmet(pr *PullRequest) []ErrorDetail {
+	var unmet []ErrorDetail
+
+	approvals := 0
+	for _, r := range pr.Reviews {
+		if r.Verdict == VerdictApproved {
+			approvals++
+		}
+		if p.BlockOnChangesRequested && r.Verdict == VerdictChangesRequested {
+			unmet = append(unmet, ErrorDetail{
+				Field:   "changes_requested",
+				Message: fmt.Sprintf("reviewer %s requested changes", r.ReviewerID),
+			})
+		}
+	}
+	if approvals < p.RequiredApprovals {
+		unmet = append(unmet, ErrorDetail{
+			Field:   "approvals",
+			Message: fmt.Sprintf("%d approvals required, %d given", p.RequiredApprovals, approvals),
+		})
+	}
+	if p.RequireFullStaff && pr.NeedMoreReviewers {
+		unmet = append(unmet, ErrorDetail{
+			Field:   "need_more_reviewers",
+			Message: "PR does not have enough reviewers",
+		})
+	}
+	return unmet
+}
+
+// NewMergeBlockedError возвращает MERGE_BLOCKED с перечнем невыполненных условий.
+func NewMergeBlockedError(unmet []ErrorDetail) *Error {
+	err := NewError(CodeMergeBlocked, "merge blocked by policy")
+	err.Details = unmet
+	return err
+}
+
+// MergeOverride — merge в обход политики администратором. Reason обязателен и попадает в журнал аудита.
+type MergeOverride struct {
+	Actor  string
+	Reason string
+}
@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergePolicy_Unmet(t *testing.T) {
+	pr := &PullRequest{
+		ID:                "pr-1",
+		NeedMoreReviewers: true,
+		Reviews: []Review{
+			{ReviewerID: "u1", Verdict: VerdictApproved},
+			{ReviewerID: "u2", Verdict: VerdictChangesRequested},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		policy     MergePolicy
+		wantFields []string
+	}{
+		{"no policy", MergePolicy{}, nil},
+		{"enough approvals", MergePolicy{RequiredApprovals: 1}, nil},
+		{"not enough approvals", MergePolicy{RequiredApprovals: 2}, []string{"approvals"}},
+		{"changes requested", MergePolicy{BlockOnChangesRequested: true}, []string{"changes_requested"}},
+		{"full staff", MergePolicy{RequireFullStaff: true}, []string{"need_more_reviewers"}},
+		{
+			"all conditions",
+			MergePolicy{RequiredApprovals: 2, BlockOnChangesRequested: true, RequireFullStaff: true},
+			[]string{"changes_requested", "approvals", "need_more_reviewers"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fields []string
+			for _, d := range tt.policy.Unmet(pr) {
+				fields = append(fields, d.Field)
+			}
+			assert.Equal(t, tt.wantFields, fields)
+		})
+	}
+}
+
+func TestMergePolicy_Strictest(t *testing.T) {
+	team := MergePolicy{RequiredApprovals: 1, BlockOnChangesRequested: true}
+	repo := MergePolicy{RequiredApprovals: 2, RequireFullStaff: true}
+
+	assert.Equal(t, MergePolicy{RequiredApprovals: 2, BlockOnChangesRequested: true, RequireFullStaff: true}, team.Strictest(repo))
+}
@@ -0,0 +1,42 @@
+package domain
+
+import "time"
+
+var ErrOutOfOfficeNotFound = NewError(CodeNotFound, "out-of-office period not found")
+
+// MaxOutOfOfficeReasonLength ограничивает длину причины отсутствия.
+const MaxOutOfOfficeReasonLength = 200
+
+// OutOfOffice — период отсутствия пользователя. Пока период идёт, пользователь не назначается
+// ревьювером; если ReassignReviews, при начале периода его открытые ревью передаются другим.
+type OutOfOffice struct {
+	ID              int64
+	UserID          string
+	StartsAt        time.Time
+	EndsAt          time.Time
+	Reason          string
+	ReassignReviews bool
+	// ReassignedAt — когда планировщик обработал начало периода; nil, пока не обработан.
+	ReassignedAt *time.Time
+	CreatedAt    time.Time
+}
+
+// Active сообщает, приходится ли момент at на период отсутствия.
+func (o *OutOfOffice) Active(at time.Time) bool {
+	return !at.Before(o.StartsAt) && at.Before(o.EndsAt)
+}
+
+// Validate проверяет период относительно текущего момента now: период должен заканчиваться
+// после начала и ещё не закончиться.
+func (o *OutOfOffice) Validate(now time.Time) error {
+	if !o.EndsAt.After(o.StartsAt) {
+		return NewError(CodeIncorrectData, "ends_at must be after starts_at")
+	}
+	if !o.EndsAt.After(now) {
+		return NewError(CodeIncorrectData, "out-of-office period is already over")
+	}
+	if len([]rune(o.Reason)) > MaxOutOfOfficeReasonLength {
+		return NewError(CodeIncorrectData, "reason is too long")
+	}
+	return nil
+}
@@ -1,38 +1,154 @@
 package domain
 
 import (
-	"errors"
+	"fmt"
+	"slices"
+	"strings"
 	"time"
 )
 
 var (
-	ErrPRNotFound       = errors.New("pr not found")
-	ErrPRAlreadyExists  = errors.New("pr already exists")
-	ErrReassignPRMerged = errors.New("cannot reassign on merged PR")
+	ErrPRNotFound       = NewError(CodeNotFound, "pr not found")
+	ErrPRAlreadyExists  = NewError(CodePRExists, "PR id already exists")
+	ErrReassignPRMerged = NewError(CodePRMerged, "cannot reassign on merged PR")
+	ErrNotAssigned      = NewError(CodeNotAssigned, "reviewer is not assigned to this PR")
+	ErrNoCandidate      = NewError(CodeNoCandidate, "no active replacement candidate in team")
+	ErrPRNotOpen        = NewError(CodePRNotOpen, "PR is not open")
+	ErrPRMerged         = NewError(CodePRMerged, "PR is merged")
+	ErrPRNotDraft       = NewError(CodeInvalidTransition, "PR is not a draft")
+	ErrPRNotClosed      = NewError(CodeInvalidTransition, "PR is not closed")
+	ErrAlreadyAssigned  = NewError(CodeAlreadyAssigned, "reviewer is already assigned to this PR")
+)
+
+// Причины, по которым пользователя нельзя вручную назначить ревьювером PR.
+var (
+	ErrReviewerIsAuthor    = NewError(CodeReviewerNotEligible, "author cannot review own PR")
+	ErrReviewerInactive    = NewError(CodeReviewerNotEligible, "reviewer is inactive")
+	ErrReviewerOutOfOffice = NewError(CodeReviewerNotEligible, "reviewer is out of office")
+	ErrReviewerAtCapacity  = NewError(CodeReviewerNotEligible, "reviewer has reached the open reviews limit")
+	ErrReviewerOutsidePool = NewError(CodeReviewerNotEligible, "reviewer is neither in the repository pool nor in the author's team")
+	ErrReviewersLimit      = NewError(CodeReviewerNotEligible, "PR already has the maximum number of reviewers")
 )
 
 type Status string
 
 const (
+	// Draft — черновик: ревьюверы не назначаются, пока автор не отметит PR готовым.
+	Draft  Status = "DRAFT"
 	Open   Status = "OPEN"
 	Merged Status = "MERGED"
+	// Closed — PR закрыт без merge; его ревью не учитываются в нагрузке ревьюверов.
+	Closed Status = "CLOSED"
 )
 
+// transitions — допустимые переходы между статусами PR. MERGED — конечный статус.
+var transitions = map[Status][]Status{
+	Draft:  {Open, Closed},
+	Open:   {Merged, Closed},
+	Closed: {Open},
+}
+
 type PullRequest struct {
-	ID                string
-	Name              string
-	AuthorID          string
+	ID          string
+	Name        string
+	AuthorID    string
+	Description string
+	Labels      []string
+	// Priority — срочность PR; по умолчанию PriorityNormal.
+	Priority          Priority
 	Status            Status
 	AssignedReviewers []string
 	NeedMoreReviewers bool
 	CreatedAt         time.Time
 	MergedAt          *time.Time
+	RepositoryID      string
+	ChangedFiles      []string
+	// RequiredSkills — навыки, которые должны быть покрыты ревьюверами; MissingSkills — те из них,
+	// которые не покрыл ни один назначенный ревьювер.
+	RequiredSkills []string
+	MissingSkills  []string
+	// ReviewDueAt — срок ревью по SLA из правил ревью команды автора (см. ReviewRule); nil — срок не задан.
+	ReviewDueAt *time.Time
+	// Size — объём изменений PR; nil, если он неизвестен.
+	Size *PullRequestSize
+	// ReviewersCount — сколько ревьюверов нужно PR по правилам ревью команды автора;
+	// 0 — по настройкам репозитория.
+	ReviewersCount int
+	// Reviews — состояние ревью каждого назначенного ревьювера.
+	Reviews []Review
+	// Assignments объясняет выбор каждого ревьювера; заполняется только при создании PR.
+	Assignments []ReviewerAssignment
+	// Forge — PR во внешнем форже, из которого импортирован этот PR; nil для PR, созданных через API.
+	Forge *ForgePullRequest
+	// Version увеличивается при каждом изменении PR; по ней проверяется If-Match.
+	Version int64
+}
+
+// AssignmentSource — по какому правилу выбран ревьювер.
+type AssignmentSource string
+
+const (
+	AssignmentCodeOwners AssignmentSource = "codeowners"
+	AssignmentRepository AssignmentSource = "repository"
+	AssignmentTeam       AssignmentSource = "team"
+)
+
+// ReviewerAssignment — причина выбора ревьювера. Для CODEOWNERS указываются строка и шаблон
+// сработавшего правила и владелец из него, через которого найден пользователь.
+// Skills — требуемые навыки PR, которыми владеет ревьювер.
+type ReviewerAssignment struct {
+	UserID  string
+	Source  AssignmentSource
+	Line    int
+	Pattern string
+	Owner   string
+	Skills  []string
 }
 
 func (s Status) String() string {
 	return string(s)
 }
 
-func (p *PullRequest) ChangeStatus(status Status) {
-	p.Status = status
+// CanTransitionTo сообщает, можно ли перевести PR из статуса s в статус to.
+func (s Status) CanTransitionTo(to Status) bool {
+	return slices.Contains(transitions[s], to)
+}
+
+// CheckStaffable возвращает ошибку, если у PR в статусе s нельзя менять автора и ревьюверов:
+// это допустимо только у черновика и открытого PR.
+func (s Status) CheckStaffable() error {
+	switch s {
+	case Draft, Open:
+		return nil
+	case Merged:
+		return ErrPRMerged
+	}
+	return ErrPRNotOpen
+}
+
+// NewTransitionError возвращает INVALID_TRANSITION для перехода from → to.
+func NewTransitionError(from, to Status) *Error {
+	return NewError(CodeInvalidTransition, fmt.Sprintf("cannot change PR status from %s to %s", from, to))
+}
+
+// Transition переводит PR в статус to или возвращает INVALID_TRANSITION, если переход недопустим.
+func (p *PullRequest) Transition(to Status) error {
+	if !p.Status.CanTransitionTo(to) {
+		return NewTransitionError(p.Status, to)
+	}
+	p.Status = to
+	return nil
+}
+
+// PullRequestFilter — условия отбора PR в списках. Пустое условие не ограничивает выборку.
+type PullRequestFilter struct {
+	Label    string
+	Priority Priority
+}
+
+// Normalize приводит метку фильтра к виду, в котором метки хранятся у PR, чтобы она сравнивалась
+// без учёта регистра.
+func (f PullRequestFilter) Normalize() PullRequestFilter {
+	f.Label = strings.ToLower(strings.TrimSpace(f.Label))
+	return f
 }
@@ -0,0 +1,79 @@
+package domain
+
+import "fmt"
+
+// SizeBucket — размер PR по числу изменённых строк и файлов.
+type SizeBucket string
+
+const (
+	SizeXS SizeBucket = "XS"
+	SizeS  SizeBucket = "S"
+	SizeM  SizeBucket = "M"
+	SizeL  SizeBucket = "L"
+	SizeXL SizeBucket = "XL"
+)
+
+// sizeBuckets — границы размеров по возрастанию: PR попадает в первый размер, в пределы которого
+// укладываются и строки, и файлы. Всё, что больше L, — XL.
+var sizeBuckets = []struct {
+	bucket   SizeBucket
+	maxLines int
+	maxFiles int
+}{
+	{SizeXS, 10, 1},
+	{SizeS, 50, 5},
+	{SizeM, 250, 15},
+	{SizeL, 1000, 40},
+}
+
+func (b SizeBucket) String() string {
+	return string(b)
+}
+
+func (b SizeBucket) Validate() error {
+	switch b {
+	case SizeXS, SizeS, SizeM, SizeL, SizeXL:
+		return nil
+	}
+	return NewError(CodeIncorrectData, fmt.Sprintf("unknown size %q", b))
+}
+
+// MaxSizeValue ограничивает строки и файлы PR, чтобы они помещались в int-колонки Postgres.
+const MaxSizeValue = 1_000_000_000
+
+// PullRequestSize — объём изменений PR, как его сообщил клиент или форж.
+type PullRequestSize struct {
+	LinesAdded   int
+	LinesRemoved int
+	FilesChanged int
+}
+
+func (s *PullRequestSize) Validate() error {
+	if s.LinesAdded < 0 || s.LinesRemoved < 0 || s.FilesChanged < 0 {
+		return NewError(CodeIncorrectData, "lines_added, lines_removed and files_changed must not be negative")
+	}
+	if s.LinesAdded > MaxSizeValue || s.LinesRemoved > MaxSizeValue || s.FilesChanged > MaxSizeValue {
+		return NewError(CodeIncorrectData,
+			fmt.Sprintf("lines_added, lines_removed and files_changed must not exceed %d", MaxSizeValue))
+	}
+	return nil
+}
+
+// Bucket возвращает размер PR: изменённые строки считаются как добавленные плюс удалённые.
+// Сумма не вычисляется явно, чтобы большие значения не переполняли int.
+func (s *PullRequestSize) Bucket() SizeBucket {
+	for _, b := range sizeBuckets {
+		if s.LinesAdded <= b.maxLines && s.LinesRemoved <= b.maxLines-s.LinesAdded && s.FilesChanged <= b.maxFiles {
+			return b.bucket
+		}
+	}
+	return SizeXL
+}
+
+// SizeBucket возвращает размер PR или пустую строку, если объём изменений неизвестен.
+func (p *PullRequest) SizeBucket() SizeBucket {
+	if p.Size == nil {
+		return ""
+	}
+	return p.Size.Bucket()
+}
@@ -0,0 +1,32 @@
+package domain
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPullRequestSize_Bucket(t *testing.T) {
+	tests := []struct {
+		size PullRequestSize
+		want SizeBucket
+	}{
+		{PullRequestSize{}, SizeXS},
+		{PullRequestSize{LinesAdded: 6, LinesRemoved: 4, FilesChanged: 1}, SizeXS},
+		{PullRequestSize{LinesAdded: 6, LinesRemoved: 5, FilesChanged: 1}, SizeS},
+		{PullRequestSize{LinesAdded: 2, FilesChanged: 6}, SizeM},
+		{PullRequestSize{LinesAdded: 900, LinesRemoved: 100, FilesChanged: 12}, SizeL},
+		{PullRequestSize{LinesAdded: 3000, FilesChanged: 3}, SizeXL},
+		{PullRequestSize{FilesChanged: 41}, SizeXL},
+		{PullRequestSize{LinesAdded: math.MaxInt, LinesRemoved: math.MaxInt}, SizeXL},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, tt.size.Bucket(), tt.size)
+	}
+
+	assert.Equal(t, SizeBucket(""), (&PullRequest{}).SizeBucket())
+	assert.Error(t, (&PullRequestSize{LinesAdded: -1}).Validate())
+	assert.Error(t, (&PullRequestSize{LinesRemoved: MaxSizeValue + 1}).Validate())
+	assert.NoError(t, (&PullRequestSize{LinesAdded: MaxSizeValue, LinesRemoved: MaxSizeValue, FilesChanged: MaxSizeValue}).Validate())
+}
@@ -0,0 +1,43 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPullRequest_Transition(t *testing.T) {
+	tests := []struct {
+		from, to Status
+		allowed  bool
+	}{
+		{Draft, Open, true},
+		{Draft, Closed, true},
+		{Draft, Merged, false},
+		{Open, Merged, true},
+		{Open, Closed, true},
+		{Open, Draft, false},
+		{Closed, Open, true},
+		{Closed, Merged, false},
+		{Closed, Draft, false},
+		{Merged, Open, false},
+		{Merged, Closed, false},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.from)+"->"+string(tt.to), func(t *testing.T) {
+			pr := &PullRequest{Status: tt.from}
+			err := pr.Transition(tt.to)
+			if tt.allowed {
+				require.NoError(t, err)
+				assert.Equal(t, tt.to, pr.Status)
+				return
+			}
+
+			var domainErr *Error
+			require.ErrorAs(t, err, &domainErr)
+			assert.Equal(t, CodeInvalidTransition, domainErr.Code)
+			assert.Equal(t, tt.from, pr.Status)
+		})
+	}
+}
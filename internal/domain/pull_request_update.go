@@ -0,0 +1,77 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+const (
+	MaxLabels              = 20
+	MaxLabelLength         = 50
+	MaxPRDescriptionLength = 10000
+)
+
+// PullRequestUpdate — изменение метаданных PR. Поля со значением nil не меняются.
+type PullRequestUpdate struct {
+	Name        *string
+	AuthorID    *string
+	Description *string
+	Labels      *[]string
+	Priority    *Priority
+}
+
+// Apply проверяет изменение и применяет его к pr. Метки нормализуются (см. NormalizeLabels).
+func (u *PullRequestUpdate) Apply(pr *PullRequest) error {
+	if u.Name != nil {
+		name := strings.TrimSpace(*u.Name)
+		if name == "" {
+			return NewError(CodeIncorrectData, "pull_request_name must not be empty")
+		}
+		pr.Name = name
+	}
+	if u.AuthorID != nil {
+		if *u.AuthorID == "" {
+			return NewError(CodeIncorrectData, "author_id must not be empty")
+		}
+		pr.AuthorID = *u.AuthorID
+	}
+	if u.Description != nil {
+		if len([]rune(*u.Description)) > MaxPRDescriptionLength {
+			return NewError(CodeIncorrectData, fmt.Sprintf("description must be at most %d characters", MaxPRDescriptionLength))
+		}
+		pr.Description = *u.Description
+	}
+	if u.Labels != nil {
+		labels, err := NormalizeLabels(*u.Labels)
+		if err != nil {
+			return err
+		}
+		pr.Labels = labels
+	}
+	if u.Priority != nil {
+		if err := u.Priority.Validate(); err != nil {
+			return err
+		}
+		pr.Priority = *u.Priority
+	}
+	return nil
+}
+
+// NormalizeLabels приводит метки PR к нижнему регистру, убирает повторы и сортирует.
+func NormalizeLabels(labels []string) ([]string, error) {
+	res := make([]string, 0, len(labels))
+	for _, label := range labels {
+		label = strings.ToLower(strings.TrimSpace(label))
+		if label == "" || len([]rune(label)) > MaxLabelLength || strings.ContainsAny(label, " \t,") {
+			return nil, NewError(CodeIncorrectData, fmt.Sprintf("invalid label %q", label))
+		}
+		res = append(res, label)
+	}
+	slices.Sort(res)
+	res = slices.Compact(res)
+	if len(res) > MaxLabels {
+		return nil, NewError(CodeIncorrectData, fmt.Sprintf("at most %d labels are allowed", MaxLabels))
+	}
+	return res, nil
+}
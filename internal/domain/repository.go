@@ -0,0 +1,47 @@
+package domain
+
+import "time"
+
+var (
+	ErrRepositoryNotFound = NewError(CodeNotFound, "repository not found")
+	ErrRepositoryExists   = NewError(CodeRepositoryExists, "repository_id already exists")
+)
+
+const (
+	DefaultReviewersCount = 2
+	MaxReviewersCount     = 5
+)
+
+// Repository — репозиторий с командами-владельцами и пулом ревьюверов. Ревьюверы PR репозитория
+// выбираются из участников команд-владельцев и явно перечисленных пользователей.
+type Repository struct {
+	ID         string
+	Name       string
+	OwnerTeams []string
+	Reviewers  []string
+	Settings   RepositorySettings
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// RepositorySettings — политика назначения ревьюверов в репозитории.
+type RepositorySettings struct {
+	// ReviewersCount — сколько ревьюверов назначать на PR.
+	ReviewersCount int
+	// AuthorTeamFallback разрешает добирать ревьюверов из команды автора, если пул исчерпан.
+	AuthorTeamFallback bool
+	// MergePolicy — условия merge PR репозитория.
+	MergePolicy MergePolicy
+}
+
+// HasPool сообщает, ограничен ли выбор ревьюверов пулом репозитория.
+func (r *Repository) HasPool() bool {
+	return len(r.OwnerTeams) > 0 || len(r.Reviewers) > 0
+}
+
+func (r *Repository) Validate() error {
+	if r.Settings.ReviewersCount < 1 || r.Settings.ReviewersCount > MaxReviewersCount {
+		return NewError(CodeIncorrectData, "settings.reviewers_count must be between 1 and 5")
+	}
+	return r.Settings.MergePolicy.Validate()
+}
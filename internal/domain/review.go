@@ -0,0 +1,45 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+var ErrReviewPRMerged = NewError(CodePRMerged, "cannot review merged PR")
+
+// MaxReviewCommentLength ограничивает длину комментария к вердикту.
+const MaxReviewCommentLength = 2000
+
+// Verdict — итог ревью, который ревьювер сообщает сервису.
+type Verdict string
+
+const (
+	// VerdictPending — ревьювер назначен, но ещё не отправил вердикт.
+	VerdictPending          Verdict = "PENDING"
+	VerdictApproved         Verdict = "APPROVED"
+	VerdictChangesRequested Verdict = "CHANGES_REQUESTED"
+	VerdictCommented        Verdict = "COMMENTED"
+)
+
+func (v Verdict) String() string {
+	return string(v)
+}
+
+// Validate проверяет, что вердикт можно отправить: PENDING ревьювер отправить не может.
+func (v Verdict) Validate() error {
+	switch v {
+	case VerdictApproved, VerdictChangesRequested, VerdictCommented:
+		return nil
+	}
+	return NewError(CodeIncorrectData, fmt.Sprintf("unknown verdict %q", v))
+}
+
+// Review — последний вердикт ревьювера по PR. Повторная отправка заменяет предыдущий вердикт;
+// при замене ревьювера новый начинает с PENDING.
+type Review struct {
+	ReviewerID  string
+	Verdict     Verdict
+	Comment     string
+	AssignedAt  time.Time
+	SubmittedAt *time.Time
+}
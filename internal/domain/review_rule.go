@@ -0,0 +1,137 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"time"
+)
+
+const (
+	MaxReviewRules = 20
+	MaxReviewSLA   = 30 * 24 * time.Hour
+)
+
+// Priority — срочность PR. Вместе с метками используется правилами ревью команды (ReviewRule).
+type Priority string
+
+const (
+	PriorityLow    Priority = "LOW"
+	PriorityNormal Priority = "NORMAL"
+	PriorityHigh   Priority = "HIGH"
+	PriorityUrgent Priority = "URGENT"
+)
+
+func (p Priority) String() string {
+	return string(p)
+}
+
+func (p Priority) Validate() error {
+	switch p {
+	case PriorityLow, PriorityNormal, PriorityHigh, PriorityUrgent:
+		return nil
+	}
+	return NewError(CodeIncorrectData, fmt.Sprintf("unknown priority %q", p))
+}
+
+// ReviewRule — правило команды автора для PR с меткой Label, приоритетом Priority и (или) размером Size;
+// пустое значение условия означает «любой». Сработавшее правило добавляет PR требуемые навыки, например
+// навык senior для меток hotfix и security, задаёт SLA — за сколько ревью должно быть выполнено
+// с момента назначения ревьюверов — и ReviewersCount — сколько ревьюверов назначить.
+// Нулевые SLA и ReviewersCount ничего не меняют.
+type ReviewRule struct {
+	Label          string
+	Priority       Priority
+	Size           SizeBucket
+	RequiredSkills []string
+	SLA            time.Duration
+	ReviewersCount int
+}
+
+// Matches сообщает, подходит ли правило к PR.
+func (r ReviewRule) Matches(pr *PullRequest) bool {
+	if r.Label != "" && !slices.Contains(pr.Labels, r.Label) {
+		return false
+	}
+	if r.Priority != "" && r.Priority != pr.Priority {
+		return false
+	}
+	if r.Size != "" && r.Size != pr.SizeBucket() {
+		return false
+	}
+	return true
+}
+
+// NormalizeReviewRules проверяет правила и приводит их метки и навыки к виду, в котором они хранятся у PR.
+func NormalizeReviewRules(rules []ReviewRule) ([]ReviewRule, error) {
+	if len(rules) > MaxReviewRules {
+		return nil, NewError(CodeIncorrectData, fmt.Sprintf("at most %d review rules are allowed", MaxReviewRules))
+	}
+	res := make([]ReviewRule, len(rules))
+	for i, r := range rules {
+		if r.Label == "" && r.Priority == "" && r.Size == "" {
+			return nil, NewError(CodeIncorrectData, fmt.Sprintf("rules[%d]: label, priority or size is required", i))
+		}
+		if r.Label != "" {
+			labels, err := NormalizeLabels([]string{r.Label})
+			if err != nil {
+				return nil, err
+			}
+			r.Label = labels[0]
+		}
+		if r.Priority != "" {
+			if err := r.Priority.Validate(); err != nil {
+				return nil, err
+			}
+		}
+		if r.Size != "" {
+			if err := r.Size.Validate(); err != nil {
+				return nil, err
+			}
+		}
+		skills, err := NormalizeSkills(r.RequiredSkills)
+		if err != nil {
+			return nil, err
+		}
+		r.RequiredSkills = skills
+		if r.SLA < 0 || r.SLA > MaxReviewSLA {
+			return nil, NewError(CodeIncorrectData, fmt.Sprintf("rules[%d]: sla must be between 0 and %s", i, MaxReviewSLA))
+		}
+		if r.ReviewersCount < 0 || r.ReviewersCount > MaxReviewersCount {
+			return nil, NewError(CodeIncorrectData, fmt.Sprintf("rules[%d]: reviewers_count must be between 0 and %d", i, MaxReviewersCount))
+		}
+		res[i] = r
+	}
+	return res, nil
+}
+
+// ApplyReviewRules применяет к PR все подходящие правила: требуемые навыки объединяются, из SLA
+// берётся самый короткий, от которого считается pr.ReviewDueAt относительно now, а из числа
+// ревьюверов — наибольшее. Если ни одно подходящее правило не задаёт SLA или число ревьюверов,
+// они сбрасываются. Навыки PR и правил уже нормализованы, поэтому объединение только убирает повторы:
+// лимит MaxSkills относится к навыкам, переданным клиентом, а не к их сумме с навыками правил.
+func ApplyReviewRules(pr *PullRequest, rules []ReviewRule, now time.Time) {
+	var (
+		skills    = append(make([]string, 0, len(pr.RequiredSkills)), pr.RequiredSkills...)
+		sla       time.Duration
+		reviewers int
+	)
+	for _, r := range rules {
+		if !r.Matches(pr) {
+			continue
+		}
+		skills = append(skills, r.RequiredSkills...)
+		if r.SLA > 0 && (sla == 0 || r.SLA < sla) {
+			sla = r.SLA
+		}
+		reviewers = max(reviewers, r.ReviewersCount)
+	}
+
+	slices.Sort(skills)
+	pr.RequiredSkills = slices.Compact(skills)
+	pr.ReviewersCount = reviewers
+	pr.ReviewDueAt = nil
+	if sla > 0 {
+		dueAt := now.Add(sla)
+		pr.ReviewDueAt = &dueAt
+	}
+}
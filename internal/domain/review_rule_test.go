@@ -0,0 +1,94 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyReviewRules(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	rules := []ReviewRule{
+		{Label: "hotfix", RequiredSkills: []string{"senior"}, SLA: 4 * time.Hour},
+		{Priority: PriorityUrgent, SLA: time.Hour},
+		{Label: "security", Priority: PriorityHigh, RequiredSkills: []string{"security"}},
+		{Size: SizeXL, SLA: 48 * time.Hour, ReviewersCount: 3},
+		{Size: SizeXS, ReviewersCount: 1},
+	}
+
+	tests := []struct {
+		name          string
+		pr            *PullRequest
+		wantSkills    []string
+		wantDueAt     *time.Time
+		wantReviewers int
+	}{
+		{"no match", &PullRequest{Labels: []string{"docs"}, Priority: PriorityNormal}, []string{}, nil, 0},
+		{
+			"label",
+			&PullRequest{Labels: []string{"hotfix"}, Priority: PriorityNormal, RequiredSkills: []string{"go"}},
+			[]string{"go", "senior"},
+			ptr(now.Add(4 * time.Hour)),
+			0,
+		},
+		{"shortest sla", &PullRequest{Labels: []string{"hotfix"}, Priority: PriorityUrgent}, []string{"senior"}, ptr(now.Add(time.Hour)), 0},
+		{"both conditions required", &PullRequest{Labels: []string{"security"}, Priority: PriorityNormal}, []string{}, nil, 0},
+		{"both conditions met", &PullRequest{Labels: []string{"security"}, Priority: PriorityHigh}, []string{"security"}, nil, 0},
+		{"unknown size", &PullRequest{Priority: PriorityNormal, ReviewersCount: 3}, []string{}, nil, 0},
+		{
+			"large",
+			&PullRequest{Priority: PriorityUrgent, Size: &PullRequestSize{LinesAdded: 2500, LinesRemoved: 600, FilesChanged: 80}},
+			[]string{},
+			ptr(now.Add(time.Hour)),
+			3,
+		},
+		{"typo fix", &PullRequest{Priority: PriorityNormal, Size: &PullRequestSize{LinesAdded: 1, LinesRemoved: 1, FilesChanged: 1}}, []string{}, nil, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ApplyReviewRules(tt.pr, rules, now)
+			assert.Equal(t, tt.wantSkills, tt.pr.RequiredSkills)
+			assert.Equal(t, tt.wantDueAt, tt.pr.ReviewDueAt)
+			assert.Equal(t, tt.wantReviewers, tt.pr.ReviewersCount)
+		})
+	}
+}
+
+func TestApplyReviewRules_SkillsAboveClientLimit(t *testing.T) {
+	var prSkills, ruleSkills []string
+	for i := range MaxSkills {
+		prSkills = append(prSkills, fmt.Sprintf("pr-%02d", i))
+		ruleSkills = append(ruleSkills, fmt.Sprintf("rule-%02d", i))
+	}
+	pr := &PullRequest{Labels: []string{"hotfix"}, RequiredSkills: prSkills}
+
+	ApplyReviewRules(pr, []ReviewRule{{Label: "hotfix", RequiredSkills: append(ruleSkills, prSkills[0])}}, time.Now())
+	assert.Len(t, pr.RequiredSkills, 2*MaxSkills)
+	assert.True(t, slices.IsSorted(pr.RequiredSkills))
+}
+
+func TestNormalizeReviewRules(t *testing.T) {
+	rules, err := NormalizeReviewRules([]ReviewRule{{Label: " HotFix", RequiredSkills: []string{"Senior", "senior"}}})
+	require.NoError(t, err)
+	assert.Equal(t, []ReviewRule{{Label: "hotfix", RequiredSkills: []string{"senior"}}}, rules)
+
+	for _, invalid := range []ReviewRule{
+		{},
+		{Priority: "ASAP"},
+		{Label: "hotfix", SLA: -time.Minute},
+		{Label: "hotfix", SLA: MaxReviewSLA + time.Minute},
+		{Size: "XXL"},
+		{Size: SizeXL, ReviewersCount: MaxReviewersCount + 1},
+	} {
+		_, err = NormalizeReviewRules([]ReviewRule{invalid})
+		assert.Error(t, err, invalid)
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
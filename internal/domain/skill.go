@@ -0,0 +1,35 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+const MaxSkills = 20
+
+// UserSkills — области экспертизы пользователя (go, sql, frontend, security, ...).
+type UserSkills struct {
+	UserID string
+	Skills []string
+	// Version — версия пользователя (см. User.Version).
+	Version int64
+}
+
+// NormalizeSkills приводит навыки к нижнему регистру, убирает повторы и сортирует.
+func NormalizeSkills(skills []string) ([]string, error) {
+	res := make([]string, 0, len(skills))
+	for _, skill := range skills {
+		skill = strings.ToLower(strings.TrimSpace(skill))
+		if skill == "" || strings.ContainsAny(skill, " \t,") {
+			return nil, NewError(CodeIncorrectData, fmt.Sprintf("invalid skill %q", skill))
+		}
+		res = append(res, skill)
+	}
+	slices.Sort(res)
+	res = slices.Compact(res)
+	if len(res) > MaxSkills {
+		return nil, NewError(CodeIncorrectData, fmt.Sprintf("at most %d skills are allowed", MaxSkills))
+	}
+	return res, nil
+}
@@ -1,10 +1,8 @@
 package domain
 
-import "errors"
-
 var (
-	ErrTeamNotFound = errors.New("team not found")
-	ErrTeamExists   = errors.New("team already exists")
+	ErrTeamNotFound = NewError(CodeNotFound, "team not found")
+	ErrTeamExists   = NewError(CodeTeamExists, "team_name already exists")
 )
 
 type Team struct {
@@ -1,10 +1,8 @@
 package domain
 
-import "errors"
-
 var (
-	ErrUserNotFound        = errors.New("user not found")
-	ErrIncorrectAdminToken = errors.New("incorrect admin token")
+	ErrUserNotFound        = NewError(CodeNotFound, "user not found")
+	ErrIncorrectAdminToken = NewError(CodeUnauthorized, "incorrect admin token")
 )
 
 type User struct {
@@ -12,6 +10,8 @@ type User struct {
 	Username string
 	TeamName string
 	IsActive bool
+	// Version увеличивается при каждом изменении пользователя; по ней проверяется If-Match.
+	Version int64
 }
 
 func (u *User) ChangeActive(active bool) {
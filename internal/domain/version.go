@@ -0,0 +1,16 @@
+package domain
+
+// ErrVersionMismatch — ресурс изменился после того, как клиент прочитал его версию (If-Match).
+var ErrVersionMismatch = NewError(CodeVersionMismatch, "resource has been modified, reload it and retry")
+
+// ErrConcurrentUpdate — ресурс без If-Match не удалось изменить из-за параллельных изменений; запрос можно повторить.
+var ErrConcurrentUpdate = NewError(CodeConcurrentUpdate, "resource is being modified concurrently, retry the request")
+
+// CheckVersion возвращает ErrVersionMismatch, если ожидаемая версия expected задана и не равна current.
+// Изменяющие методы сервисов и хранилищ принимают expected явно; 0 — без проверки.
+func CheckVersion(expected int64, current int64) error {
+	if expected != 0 && expected != current {
+		return ErrVersionMismatch
+	}
+	return nil
+}
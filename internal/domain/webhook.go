@@ -0,0 +1,38 @@
+package domain
+
+import "time"
+
+var (
+	ErrWebhookNotFound   = NewError(CodeNotFound, "webhook subscription not found")
+	ErrInvalidWebhookURL = NewError(CodeIncorrectData, "webhook url must be an absolute http(s) url")
+)
+
+type WebhookSubscription struct {
+	ID        string
+	URL       string
+	Secret    string
+	Events    []EventType
+	CreatedAt time.Time
+}
+
+// WebhookDelivery — запись об одной попытке доставки события подписчику.
+type WebhookDelivery struct {
+	ID             int64
+	SubscriptionID string
+	EventID        string
+	EventType      EventType
+	Attempt        int
+	StatusCode     *int
+	Error          *string
+	Success        bool
+	CreatedAt      time.Time
+}
+
+func (s *WebhookSubscription) Subscribed(eventType EventType) bool {
+	for _, t := range s.Events {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,94 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"time"
+)
+
+var ErrWorkingHoursNotFound = NewError(CodeNotFound, "working hours not set")
+
+// WorkingHoursPolicy — как команда учитывает рабочие часы при выборе ревьюверов.
+type WorkingHoursPolicy string
+
+const (
+	// WorkingHoursPrefer — сначала назначаются те, у кого сейчас рабочее время,
+	// остальные добирают недостающие места.
+	WorkingHoursPrefer WorkingHoursPolicy = "prefer"
+	// WorkingHoursRequire — вне рабочего времени пользователь не назначается.
+	WorkingHoursRequire WorkingHoursPolicy = "require"
+)
+
+func (p WorkingHoursPolicy) Validate() error {
+	if p != WorkingHoursPrefer && p != WorkingHoursRequire {
+		return NewError(CodeIncorrectData, fmt.Sprintf("unknown working hours policy %q", p))
+	}
+	return nil
+}
+
+// WorkingHours — рабочее расписание пользователя в его часовом поясе. Start и End — минуты от
+// начала суток; если End меньше Start, смена переходит через полночь и относится к дню начала.
+// Пользователь без расписания считается доступным в любое время.
+type WorkingHours struct {
+	UserID   string
+	TimeZone string
+	Days     []time.Weekday
+	Start    int
+	End      int
+	// Policy — политика команды пользователя; заполняется при выборе ревьюверов.
+	Policy WorkingHoursPolicy
+}
+
+func (w *WorkingHours) Validate() error {
+	if _, err := time.LoadLocation(w.TimeZone); err != nil || w.TimeZone == "" {
+		return NewError(CodeIncorrectData, fmt.Sprintf("unknown time zone %q", w.TimeZone))
+	}
+	if len(w.Days) == 0 {
+		return NewError(CodeIncorrectData, "at least one working day is required")
+	}
+	for _, d := range w.Days {
+		if d < time.Sunday || d > time.Saturday {
+			return NewError(CodeIncorrectData, fmt.Sprintf("invalid weekday %d", d))
+		}
+	}
+	if w.Start < 0 || w.Start >= 24*60 || w.End < 0 || w.End >= 24*60 {
+		return NewError(CodeIncorrectData, "working hours must be within a day")
+	}
+	if w.Start == w.End {
+		return NewError(CodeIncorrectData, "working hours must not be empty")
+	}
+	return nil
+}
+
+// ParseClockTime переводит время суток в формате HH:MM в минуты от начала суток.
+func ParseClockTime(s string) (int, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, NewError(CodeIncorrectData, fmt.Sprintf("invalid time of day %q, expected HH:MM", s))
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// FormatClockTime — обратное к ParseClockTime преобразование.
+func FormatClockTime(minutes int) string {
+	return fmt.Sprintf("%02d:%02d", minutes/60, minutes%60)
+}
+
+// Within сообщает, приходится ли момент at на рабочее время пользователя.
+func (w *WorkingHours) Within(at time.Time) bool {
+	loc, err := time.LoadLocation(w.TimeZone)
+	if err != nil {
+		loc = time.UTC
+	}
+	local := at.In(loc)
+	minute := local.Hour()*60 + local.Minute()
+	day := local.Weekday()
+
+	if w.Start < w.End {
+		return slices.Contains(w.Days, day) && minute >= w.Start && minute < w.End
+	}
+	// Смена через полночь: вечер дня начала или утро следующего за ним дня.
+	previous := (day + 6) % 7
+	return (slices.Contains(w.Days, day) && minute >= w.Start) ||
+		(slices.Contains(w.Days, previous) && minute < w.End)
+}
@@ -1,43 +1,79 @@
 package err
 
-type ErrorResponse struct {
-	Error Err `json:"error"`
-}
+import (
+	"errors"
+	"net/http"
 
-type Err struct {
-	Code    string `json:"code"`
-	Message string `json:"message"`
-}
+	"github.com/LeoUraltsev/PRReviewerService/api"
+	"github.com/LeoUraltsev/PRReviewerService/internal/domain"
+	"github.com/go-chi/render"
+)
 
-func NewErrorResponse(code string, message string) *ErrorResponse {
-	return &ErrorResponse{
-		Error: Err{
-			Code:    code,
-			Message: message,
-		},
-	}
+// statusByCode задаёт HTTP-статус для каждого кода из каталога доменных ошибок.
+var statusByCode = map[domain.ErrorCode]int{
+	domain.CodeNotFound:            http.StatusNotFound,
+	domain.CodeTeamExists:          http.StatusBadRequest,
+	domain.CodeRepositoryExists:    http.StatusConflict,
+	domain.CodePRExists:            http.StatusConflict,
+	domain.CodePRMerged:            http.StatusConflict,
+	domain.CodeNotAssigned:         http.StatusConflict,
+	domain.CodeAlreadyAssigned:     http.StatusConflict,
+	domain.CodeReviewerNotEligible: http.StatusConflict,
+	domain.CodeNoCandidate:         http.StatusConflict,
+	domain.CodeMergeBlocked:        http.StatusConflict,
+	domain.CodePRNotOpen:           http.StatusConflict,
+	domain.CodeInvalidTransition:   http.StatusConflict,
+	domain.CodeIncorrectData:       http.StatusBadRequest,
+	domain.CodeUnauthorized:        http.StatusUnauthorized,
+	domain.CodeVersionMismatch:     http.StatusPreconditionFailed,
+	domain.CodeConcurrentUpdate:    http.StatusConflict,
 }
 
-func NotFoundError() *ErrorResponse {
-	return NewErrorResponse("NOT_FOUND", "resource not found")
+// FromError сопоставляет ошибку сервиса HTTP-статусу и телу ответа.
+// Ошибки вне каталога domain.Error считаются внутренними и не раскрываются клиенту.
+func FromError(err error) (int, api.ErrorResponse) {
+	var domainErr *domain.Error
+	if !errors.As(err, &domainErr) {
+		return http.StatusInternalServerError, InternalServerError()
+	}
+	status, ok := statusByCode[domainErr.Code]
+	if !ok {
+		return http.StatusInternalServerError, InternalServerError()
+	}
+	resp := NewErrorResponse(api.ErrorCode(domainErr.Code), domainErr.Message)
+	for _, d := range domainErr.Details {
+		resp.Error.Details = append(resp.Error.Details, api.FieldError{Field: d.Field, Message: d.Message})
+	}
+	return status, resp
 }
 
-func InternalServerError() *ErrorResponse {
-	return NewErrorResponse("INTERNAL_SERVER_ERROR", "internal server error")
+// WriteError пишет ответ с ошибкой, полученный из FromError.
+func WriteError(w http.ResponseWriter, r *http.Request, err error) int {
+	status, resp := FromError(err)
+	w.WriteHeader(status)
+	render.JSON(w, r, resp)
+	return status
 }
 
-func TeamExistsError() *ErrorResponse {
-	return NewErrorResponse("TEAM_EXISTS", "team_name already exists")
+func NewErrorResponse(code api.ErrorCode, message string) api.ErrorResponse {
+	return api.ErrorResponse{
+		Error: api.Error{
+			Code:    code,
+			Message: message,
+		},
+	}
 }
 
-func PRExistsError() *ErrorResponse {
-	return NewErrorResponse("PR_EXISTS", "PR id already exists")
+func ValidationError(details []api.FieldError) api.ErrorResponse {
+	resp := NewErrorResponse(api.ErrorCodeIncorrectData, "request validation failed")
+	resp.Error.Details = details
+	return resp
 }
 
-func PRMergedError() *ErrorResponse {
-	return NewErrorResponse("PR_MERGED", "cannot reassign on merged PR")
+func InternalServerError() api.ErrorResponse {
+	return NewErrorResponse(api.ErrorCodeInternalServerError, "internal server error")
 }
 
-func IncorrectDataError() *ErrorResponse {
-	return NewErrorResponse("INCORRECT_DATA", "incorrect data")
+func IncorrectDataError() api.ErrorResponse {
+	return NewErrorResponse(api.ErrorCodeIncorrectData, "incorrect data")
 }
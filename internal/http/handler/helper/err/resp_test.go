@@ -0,0 +1,60 @@
+package err
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/LeoUraltsev/PRReviewerService/api"
+	"github.com/LeoUraltsev/PRReviewerService/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromError(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+		wantCode   api.ErrorCode
+	}{
+		{"pr not found", domain.ErrPRNotFound, http.StatusNotFound, api.ErrorCodeNotFound},
+		{"user not found", domain.ErrUserNotFound, http.StatusNotFound, api.ErrorCodeNotFound},
+		{"team not found", domain.ErrTeamNotFound, http.StatusNotFound, api.ErrorCodeNotFound},
+		{"team exists", domain.ErrTeamExists, http.StatusBadRequest, api.ErrorCodeTeamExists},
+		{"repository exists", domain.ErrRepositoryExists, http.StatusConflict, api.ErrorCodeRepositoryExists},
+		{"pr exists", domain.ErrPRAlreadyExists, http.StatusConflict, api.ErrorCodePRExists},
+		{"pr merged", domain.ErrReassignPRMerged, http.StatusConflict, api.ErrorCodePRMerged},
+		{"not assigned", domain.ErrNotAssigned, http.StatusConflict, api.ErrorCodeNotAssigned},
+		{"already assigned", domain.ErrAlreadyAssigned, http.StatusConflict, api.ErrorCodeAlreadyAssigned},
+		{"reviewer not eligible", domain.ErrReviewerAtCapacity, http.StatusConflict, api.ErrorCodeReviewerNotEligible},
+		{"no candidate", domain.ErrNoCandidate, http.StatusConflict, api.ErrorCodeNoCandidate},
+		{"merge blocked", domain.NewMergeBlockedError(nil), http.StatusConflict, api.ErrorCodeMergeBlocked},
+		{"pr not open", domain.ErrPRNotOpen, http.StatusConflict, api.ErrorCodePRNotOpen},
+		{"invalid transition", domain.NewTransitionError(domain.Merged, domain.Closed), http.StatusConflict, api.ErrorCodeInvalidTransition},
+		{"incorrect admin token", domain.ErrIncorrectAdminToken, http.StatusUnauthorized, api.ErrorCodeUnauthorized},
+		{"version mismatch", domain.ErrVersionMismatch, http.StatusPreconditionFailed, api.ErrorCodeVersionMismatch},
+		{"concurrent update", domain.ErrConcurrentUpdate, http.StatusConflict, api.ErrorCodeConcurrentUpdate},
+		{"incorrect data", domain.NewError(domain.CodeIncorrectData, "bad"), http.StatusBadRequest, api.ErrorCodeIncorrectData},
+		{"wrapped domain error", fmt.Errorf("reviewer u1: %w", domain.ErrNotAssigned), http.StatusConflict, api.ErrorCodeNotAssigned},
+		{"unknown code", domain.NewError("SOMETHING", "x"), http.StatusInternalServerError, api.ErrorCodeInternalServerError},
+		{"plain error", errors.New("connection refused"), http.StatusInternalServerError, api.ErrorCodeInternalServerError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, resp := FromError(tt.err)
+			assert.Equal(t, tt.wantStatus, status)
+			assert.Equal(t, tt.wantCode, resp.Error.Code)
+			assert.NotEmpty(t, resp.Error.Message)
+		})
+	}
+}
+
+func TestFromError_Details(t *testing.T) {
+	err := domain.NewMergeBlockedError([]domain.ErrorDetail{
+		{Field: "approvals", Message: "2 approvals required, 1 given"},
+	})
+
+	_, resp := FromError(fmt.Errorf("merge pr-1: %w", err))
+	assert.Equal(t, []api.FieldError{{Field: "approvals", Message: "2 approvals required, 1 given"}}, resp.Error.Details)
+}
@@ -0,0 +1,37 @@
+package etag
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/LeoUraltsev/PRReviewerService/internal/domain"
+)
+
+var errInvalidIfMatch = domain.NewError(domain.CodeIncorrectData, "invalid If-Match header, expected an ETag returned by the service")
+
+// Format возвращает ETag для версии ресурса.
+func Format(version int64) string {
+	return strconv.Quote(strconv.FormatInt(version, 10))
+}
+
+// ParseIfMatch возвращает версию из заголовка If-Match, которую обработчик передаёт изменяющему
+// методу сервиса. 0 — заголовка нет или он равен "*", то есть версия не проверяется. Принимаются ETag из Format, в том числе со слабым префиксом W/,
+// и версия без кавычек.
+func ParseIfMatch(header *string) (int64, error) {
+	if header == nil {
+		return 0, nil
+	}
+	value := strings.TrimSpace(*header)
+	if value == "" || value == "*" {
+		return 0, nil
+	}
+	value = strings.TrimPrefix(value, "W/")
+	if unquoted, err := strconv.Unquote(value); err == nil {
+		value = unquoted
+	}
+	version, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || version <= 0 {
+		return 0, errInvalidIfMatch
+	}
+	return version, nil
+}
@@ -0,0 +1,43 @@
+package etag
+
+import (
+	"testing"
+
+	"github.com/LeoUraltsev/PRReviewerService/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseIfMatch(t *testing.T) {
+	tests := []struct {
+		name    string
+		header  *string
+		want    int64
+		wantErr bool
+	}{
+		{"no header", nil, 0, false},
+		{"any", ptr("*"), 0, false},
+		{"etag", ptr(Format(3)), 3, false},
+		{"weak etag", ptr(`W/"7"`), 7, false},
+		{"bare version", ptr("12"), 12, false},
+		{"garbage", ptr(`"abc"`), 0, true},
+		{"zero", ptr(`"0"`), 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseIfMatch(tt.header)
+			if tt.wantErr {
+				var domainErr *domain.Error
+				require.ErrorAs(t, err, &domainErr)
+				assert.Equal(t, domain.CodeIncorrectData, domainErr.Code)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func ptr(s string) *string {
+	return &s
+}
@@ -0,0 +1,145 @@
+package integration
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/LeoUraltsev/PRReviewerService/internal/domain"
+	e "github.com/LeoUraltsev/PRReviewerService/internal/http/handler/helper/err"
+	"github.com/go-chi/render"
+)
+
+const (
+	HeaderGitHubEvent     = "X-GitHub-Event"
+	HeaderGitHubSignature = "X-Hub-Signature-256"
+
+	// maxPayloadSize — ограничение GitHub на размер тела вебхука.
+	maxPayloadSize = 25 << 20
+)
+
+type githubPullRequestEvent struct {
+	Action      string `json:"action"`
+	Number      int64  `json:"number"`
+	PullRequest struct {
+		Title        string `json:"title"`
+		Merged       bool   `json:"merged"`
+		Additions    *int   `json:"additions"`
+		Deletions    *int   `json:"deletions"`
+		ChangedFiles *int   `json:"changed_files"`
+		User         struct {
+			Login string `json:"login"`
+		} `json:"user"`
+	} `json:"pull_request"`
+	Repository struct {
+		FullName string `json:"full_name"`
+	} `json:"repository"`
+}
+
+// GitHubHandler принимает вебхуки GitHub. Он не входит в сгенерированный сервер:
+// подпись X-Hub-Signature-256 считается по исходному телу запроса.
+type GitHubHandler struct {
+	log      *slog.Logger
+	ingester Ingester
+	secret   string
+}
+
+func NewGitHubHandler(log *slog.Logger, ingester Ingester, secret string) *GitHubHandler {
+	return &GitHubHandler{
+		log:      log,
+		ingester: ingester,
+		secret:   secret,
+	}
+}
+
+func (h *GitHubHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxPayloadSize))
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		render.JSON(w, r, e.IncorrectDataError())
+		return
+	}
+
+	if !verifyGitHubSignature(h.secret, body, r.Header.Get(HeaderGitHubSignature)) {
+		e.WriteError(w, r, domain.ErrForgeSignature)
+		return
+	}
+
+	if eventName := r.Header.Get(HeaderGitHubEvent); eventName != "pull_request" {
+		render.JSON(w, r, ingestResultTo(&domain.IngestResult{Reason: fmt.Sprintf("event %s is not supported", eventName)}))
+		return
+	}
+
+	var payload githubPullRequestEvent
+	if err = json.Unmarshal(body, &payload); err != nil || payload.Repository.FullName == "" || payload.Number == 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		render.JSON(w, r, e.IncorrectDataError())
+		return
+	}
+
+	handlePullRequest(h.log, h.ingester, w, r, &domain.ForgePullRequestEvent{
+		Provider:    domain.ForgeGitHub,
+		Repository:  payload.Repository.FullName,
+		Number:      payload.Number,
+		Title:       payload.PullRequest.Title,
+		AuthorLogin: payload.PullRequest.User.Login,
+		Action:      githubAction(&payload),
+		Size:        githubSize(&payload),
+	})
+}
+
+// githubSize возвращает объём изменений PR, если GitHub передал все его счётчики.
+func githubSize(payload *githubPullRequestEvent) *domain.PullRequestSize {
+	pr := &payload.PullRequest
+	if pr.Additions == nil || pr.Deletions == nil || pr.ChangedFiles == nil {
+		return nil
+	}
+	return &domain.PullRequestSize{
+		LinesAdded:   *pr.Additions,
+		LinesRemoved: *pr.Deletions,
+		FilesChanged: *pr.ChangedFiles,
+	}
+}
+
+func githubAction(payload *githubPullRequestEvent) domain.ForgeAction {
+	switch payload.Action {
+	case "opened":
+		return domain.ForgeActionOpened
+	case "reopened":
+		return domain.ForgeActionReopened
+	case "edited", "synchronize":
+		return domain.ForgeActionUpdated
+	case "closed":
+		if payload.PullRequest.Merged {
+			return domain.ForgeActionMerged
+		}
+		return domain.ForgeActionClosed
+	default:
+		return domain.ForgeAction(payload.Action)
+	}
+}
+
+// verifyGitHubSignature сверяет заголовок "sha256=<hex>" с HMAC-SHA256 тела.
+// Без настроенного секрета запросы не принимаются.
+func verifyGitHubSignature(secret string, body []byte, header string) bool {
+	if secret == "" {
+		return false
+	}
+	sig, ok := strings.CutPrefix(header, "sha256=")
+	if !ok {
+		return false
+	}
+	got, err := hex.DecodeString(sig)
+	if err != nil {
+		return false
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hmac.Equal(got, mac.Sum(nil))
+}
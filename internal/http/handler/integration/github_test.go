@@ -0,0 +1,202 @@
+package integration
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/LeoUraltsev/PRReviewerService/api"
+	"github.com/LeoUraltsev/PRReviewerService/internal/domain"
+	"github.com/LeoUraltsev/PRReviewerService/internal/service/ingest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSecret = "It's a Secret to Everybody"
+
+// memoryForge — хранилище ingest.RepoForge в памяти.
+type memoryForge struct {
+	accounts map[string]string
+	repos    map[string]bool
+	refs     map[int64]string
+}
+
+func (m *memoryForge) LinkAccount(_ context.Context, a *domain.ForgeAccount) error {
+	m.accounts[a.Login] = a.UserID
+	return nil
+}
+
+func (m *memoryForge) GetUserIDByLogin(_ context.Context, _ domain.ForgeProvider, login string) (string, error) {
+	id, ok := m.accounts[login]
+	if !ok {
+		return "", domain.ErrForgeAccountNotFound
+	}
+	return id, nil
+}
+
+func (m *memoryForge) AddRepository(_ context.Context, p domain.ForgeProvider, name string) (*domain.ForgeRepository, error) {
+	m.repos[name] = true
+	return &domain.ForgeRepository{Provider: p, FullName: name}, nil
+}
+
+func (m *memoryForge) RepositoryExists(_ context.Context, _ domain.ForgeProvider, name string) (bool, error) {
+	return m.repos[name], nil
+}
+
+func (m *memoryForge) GetPullRequestRef(_ context.Context, p domain.ForgeProvider, repo string, number int64) (*domain.ForgePullRequest, error) {
+	id, ok := m.refs[number]
+	if !ok {
+		return nil, domain.ErrForgePRNotFound
+	}
+	return &domain.ForgePullRequest{Provider: p, Repository: repo, Number: number, PullRequestID: id}, nil
+}
+
+// recordingPRs запоминает вызовы сервиса PR и, как настоящее хранилище, сохраняет ссылку на форж вместе с PR.
+type recordingPRs struct {
+	forge    *memoryForge
+	created  map[string]string
+	sizes    []*domain.PullRequestSize
+	merged   []string
+	closed   []string
+	reopened []string
+}
+
+func (r *recordingPRs) ImportPullRequest(_ context.Context, ref *domain.ForgePullRequest, _ string, authorID string, size *domain.PullRequestSize) (*domain.PullRequest, error) {
+	r.created[ref.PullRequestID] = authorID
+	r.sizes = append(r.sizes, size)
+	r.forge.refs[ref.Number] = ref.PullRequestID
+	return &domain.PullRequest{ID: ref.PullRequestID, Forge: ref}, nil
+}
+
+func (r *recordingPRs) SyncMergedPullRequest(_ context.Context, prID string) (*domain.PullRequest, error) {
+	r.merged = append(r.merged, prID)
+	return &domain.PullRequest{ID: prID}, nil
+}
+
+func (r *recordingPRs) ClosePullRequest(_ context.Context, prID string, _ int64) (*domain.PullRequest, error) {
+	if slices.Contains(r.merged, prID) {
+		return nil, domain.NewTransitionError(domain.Merged, domain.Closed)
+	}
+	r.closed = append(r.closed, prID)
+	return &domain.PullRequest{ID: prID}, nil
+}
+
+func (r *recordingPRs) ReopenPullRequest(_ context.Context, prID string, _ int64) (*domain.PullRequest, error) {
+	r.reopened = append(r.reopened, prID)
+	return &domain.PullRequest{ID: prID}, nil
+}
+
+func fixture(t *testing.T, name string) []byte {
+	t.Helper()
+	body, err := os.ReadFile(filepath.Join("testdata", name))
+	require.NoError(t, err)
+	return body
+}
+
+func sign(body []byte) string {
+	mac := hmac.New(sha256.New, []byte(testSecret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func send(h http.Handler, event string, body []byte, signature string) (*httptest.ResponseRecorder, api.IngestResult) {
+	req := httptest.NewRequest(http.MethodPost, "/integrations/github/webhook", bytes.NewReader(body))
+	req.Header.Set(HeaderGitHubEvent, event)
+	req.Header.Set(HeaderGitHubSignature, signature)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+
+	var res api.IngestResult
+	_ = json.Unmarshal(w.Body.Bytes(), &res)
+	return w, res
+}
+
+func TestGitHubHandler(t *testing.T) {
+	forge := &memoryForge{
+		accounts: map[string]string{"alice-gh": "u1"},
+		repos:    map[string]bool{"acme/pr-service": true},
+		refs:     map[int64]string{},
+	}
+	prs := &recordingPRs{forge: forge, created: map[string]string{}}
+	h := NewGitHubHandler(slog.New(slog.NewTextHandler(io.Discard, nil)), ingest.NewService(forge, prs), testSecret)
+
+	opened := fixture(t, "github_pull_request_opened.json")
+
+	w, _ := send(h, "pull_request", opened, "sha256=00")
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	assert.Empty(t, prs.created)
+
+	w, res := send(h, "ping", fixture(t, "github_ping.json"), sign(fixture(t, "github_ping.json")))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, api.IngestStatusIgnored, res.Status)
+
+	w, res = send(h, "pull_request", opened, sign(opened))
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, api.IngestStatusProcessed, res.Status)
+	assert.Equal(t, map[string]string{"github:acme/pr-service#42": "u1"}, prs.created)
+	assert.Equal(t, []*domain.PullRequestSize{{LinesAdded: 120, LinesRemoved: 14, FilesChanged: 5}}, prs.sizes)
+
+	// Повторная доставка того же события не создаёт PR заново.
+	_, res = send(h, "pull_request", opened, sign(opened))
+	assert.Equal(t, api.IngestStatusIgnored, res.Status)
+
+	// Закрытие PR, который сервис не отслеживает, пропускается.
+	closed := fixture(t, "github_pull_request_closed.json")
+	_, res = send(h, "pull_request", closed, sign(closed))
+	assert.Equal(t, api.IngestStatusIgnored, res.Status)
+
+	forge.refs[43] = "github:acme/pr-service#43"
+	_, res = send(h, "pull_request", closed, sign(closed))
+	assert.Equal(t, api.IngestStatusProcessed, res.Status)
+	assert.Equal(t, []string{"github:acme/pr-service#43"}, prs.closed)
+
+	// Повторное открытие отслеживаемого PR открывает его в сервисе, а не импортирует заново.
+	reopened := fixture(t, "github_pull_request_reopened.json")
+	_, res = send(h, "pull_request", reopened, sign(reopened))
+	assert.Equal(t, api.IngestStatusProcessed, res.Status)
+	assert.Equal(t, []string{"github:acme/pr-service#43"}, prs.reopened)
+	assert.NotContains(t, prs.created, "github:acme/pr-service#43")
+
+	merged := fixture(t, "github_pull_request_merged.json")
+	_, res = send(h, "pull_request", merged, sign(merged))
+	assert.Equal(t, api.IngestStatusProcessed, res.Status)
+	assert.Equal(t, []string{"github:acme/pr-service#42"}, prs.merged)
+
+	// Закрытие во форже PR, который в сервисе уже смержен, пропускается, а не возвращает ошибку форжу.
+	forge.refs[43] = "github:acme/pr-service#42"
+	_, res = send(h, "pull_request", closed, sign(closed))
+	assert.Equal(t, api.IngestStatusIgnored, res.Status)
+	assert.Equal(t, []string{"github:acme/pr-service#43"}, prs.closed)
+}
+
+func TestGitHubHandler_IgnoresUnknownRepository(t *testing.T) {
+	forge := &memoryForge{
+		accounts: map[string]string{},
+		repos:    map[string]bool{},
+		refs:     map[int64]string{},
+	}
+	prs := &recordingPRs{forge: forge, created: map[string]string{}}
+	h := NewGitHubHandler(slog.New(slog.NewTextHandler(io.Discard, nil)), ingest.NewService(forge, prs), testSecret)
+
+	opened := fixture(t, "github_pull_request_opened.json")
+	w, res := send(h, "pull_request", opened, sign(opened))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, api.IngestStatusIgnored, res.Status)
+	assert.Empty(t, prs.created)
+
+	// Для зарегистрированного репозитория неизвестный автор — ошибка, которую видно в журнале доставок GitHub.
+	forge.repos["acme/pr-service"] = true
+	w, _ = send(h, "pull_request", opened, sign(opened))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
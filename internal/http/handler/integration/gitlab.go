@@ -0,0 +1,113 @@
+package integration
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"github.com/LeoUraltsev/PRReviewerService/internal/domain"
+	e "github.com/LeoUraltsev/PRReviewerService/internal/http/handler/helper/err"
+	"github.com/go-chi/render"
+)
+
+const (
+	HeaderGitLabEvent = "X-Gitlab-Event"
+	HeaderGitLabToken = "X-Gitlab-Token"
+
+	gitlabMergeRequestHook = "Merge Request Hook"
+)
+
+type gitlabMergeRequestEvent struct {
+	ObjectKind string `json:"object_kind"`
+	User       struct {
+		Username string `json:"username"`
+	} `json:"user"`
+	Project struct {
+		PathWithNamespace string `json:"path_with_namespace"`
+	} `json:"project"`
+	ObjectAttributes struct {
+		IID    int64  `json:"iid"`
+		Title  string `json:"title"`
+		Action string `json:"action"`
+	} `json:"object_attributes"`
+}
+
+// GitLabHandler принимает вебхуки GitLab (Merge Request Hook).
+// GitLab не подписывает тело, а передаёт секрет в X-Gitlab-Token как есть.
+type GitLabHandler struct {
+	log      *slog.Logger
+	ingester Ingester
+	token    string
+}
+
+func NewGitLabHandler(log *slog.Logger, ingester Ingester, token string) *GitLabHandler {
+	return &GitLabHandler{
+		log:      log,
+		ingester: ingester,
+		token:    token,
+	}
+}
+
+func (h *GitLabHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !verifyGitLabToken(h.token, r.Header.Get(HeaderGitLabToken)) {
+		e.WriteError(w, r, domain.ErrForgeSignature)
+		return
+	}
+
+	if eventName := r.Header.Get(HeaderGitLabEvent); eventName != gitlabMergeRequestHook {
+		render.JSON(w, r, ingestResultTo(&domain.IngestResult{Reason: fmt.Sprintf("event %s is not supported", eventName)}))
+		return
+	}
+
+	var payload gitlabMergeRequestEvent
+	err := json.NewDecoder(io.LimitReader(r.Body, maxPayloadSize)).Decode(&payload)
+	if err != nil || payload.ObjectKind != "merge_request" ||
+		payload.Project.PathWithNamespace == "" || payload.ObjectAttributes.IID == 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		render.JSON(w, r, e.IncorrectDataError())
+		return
+	}
+
+	// В Merge Request Hook нет логина автора MR, только его числовой id, а user — тот, кто вызвал событие.
+	// Автором он заведомо является только при открытии MR, поэтому логин автора передаётся лишь для open:
+	// MR, который ещё не отслеживается, по update или reopen не импортируется.
+	// Объёма изменений в событии тоже нет, поэтому размер MR остаётся неизвестным.
+	event := &domain.ForgePullRequestEvent{
+		Provider:   domain.ForgeGitLab,
+		Repository: payload.Project.PathWithNamespace,
+		Number:     payload.ObjectAttributes.IID,
+		Title:      payload.ObjectAttributes.Title,
+		Action:     gitlabAction(payload.ObjectAttributes.Action),
+	}
+	if event.Action == domain.ForgeActionOpened {
+		event.AuthorLogin = payload.User.Username
+	}
+	handlePullRequest(h.log, h.ingester, w, r, event)
+}
+
+func gitlabAction(action string) domain.ForgeAction {
+	switch action {
+	case "open":
+		return domain.ForgeActionOpened
+	case "reopen":
+		return domain.ForgeActionReopened
+	case "update":
+		return domain.ForgeActionUpdated
+	case "merge":
+		return domain.ForgeActionMerged
+	case "close":
+		return domain.ForgeActionClosed
+	default:
+		return domain.ForgeAction(action)
+	}
+}
+
+func verifyGitLabToken(expected, got string) bool {
+	if expected == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(expected), []byte(got)) == 1
+}
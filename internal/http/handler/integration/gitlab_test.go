@@ -0,0 +1,61 @@
+package integration
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/LeoUraltsev/PRReviewerService/api"
+	"github.com/LeoUraltsev/PRReviewerService/internal/service/ingest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func sendGitLab(h http.Handler, token string, body []byte) (*httptest.ResponseRecorder, api.IngestResult) {
+	req := httptest.NewRequest(http.MethodPost, "/integrations/gitlab/webhook", bytes.NewReader(body))
+	req.Header.Set(HeaderGitLabEvent, gitlabMergeRequestHook)
+	req.Header.Set(HeaderGitLabToken, token)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+
+	var res api.IngestResult
+	_ = json.Unmarshal(w.Body.Bytes(), &res)
+	return w, res
+}
+
+func TestGitLabHandler(t *testing.T) {
+	forge := &memoryForge{
+		accounts: map[string]string{"bob": "u7", "carol": "u8"},
+		repos:    map[string]bool{"payments/billing": true},
+		refs:     map[int64]string{},
+	}
+	prs := &recordingPRs{forge: forge, created: map[string]string{}}
+	h := NewGitLabHandler(slog.New(slog.NewTextHandler(io.Discard, nil)), ingest.NewService(forge, prs), testSecret)
+
+	open := fixture(t, "gitlab_merge_request_open.json")
+
+	w, _ := sendGitLab(h, "wrong", open)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	w, res := sendGitLab(h, testSecret, open)
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, api.IngestStatusProcessed, res.Status)
+
+	// MR bob обновила carol: пользователь события не автор, поэтому неотслеживаемый MR не импортируется.
+	_, res = sendGitLab(h, testSecret, fixture(t, "gitlab_merge_request_update.json"))
+	assert.Equal(t, api.IngestStatusIgnored, res.Status)
+	assert.Equal(t, map[string]string{"gitlab:payments/billing#7": "u7"}, prs.created)
+
+	_, res = sendGitLab(h, testSecret, fixture(t, "gitlab_merge_request_close.json"))
+	assert.Equal(t, api.IngestStatusProcessed, res.Status)
+	assert.Equal(t, []string{"gitlab:payments/billing#7"}, prs.closed)
+
+	// Мержит не автор: пользователь события для merge не сопоставляется.
+	_, res = sendGitLab(h, testSecret, fixture(t, "gitlab_merge_request_merge.json"))
+	assert.Equal(t, api.IngestStatusProcessed, res.Status)
+	assert.Equal(t, []string{"gitlab:payments/billing#7"}, prs.merged)
+}
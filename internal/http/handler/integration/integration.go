@@ -0,0 +1,87 @@
+package integration
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+
+	"github.com/LeoUraltsev/PRReviewerService/api"
+	"github.com/LeoUraltsev/PRReviewerService/internal/domain"
+	e "github.com/LeoUraltsev/PRReviewerService/internal/http/handler/helper/err"
+	"github.com/go-chi/render"
+)
+
+type Linker interface {
+	LinkAccount(ctx context.Context, account *domain.ForgeAccount) (*domain.ForgeAccount, error)
+	AddRepository(ctx context.Context, provider domain.ForgeProvider, fullName string) (*domain.ForgeRepository, error)
+}
+
+type Ingester interface {
+	HandlePullRequest(ctx context.Context, event *domain.ForgePullRequestEvent) (*domain.IngestResult, error)
+}
+
+// Handler обслуживает настройку интеграций с форжами: связь логинов и список репозиториев.
+type Handler struct {
+	linker Linker
+}
+
+func NewHandler(linker Linker) *Handler {
+	return &Handler{
+		linker: linker,
+	}
+}
+
+func (h *Handler) LinkForgeAccount(ctx context.Context, request api.LinkForgeAccountRequestObject) (api.LinkForgeAccountResponseObject, error) {
+	account, err := h.linker.LinkAccount(ctx, &domain.ForgeAccount{
+		Provider: domain.ForgeProvider(request.Body.Provider),
+		Login:    request.Body.Login,
+		UserID:   request.Body.UserId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return api.LinkForgeAccount201JSONResponse{
+		Provider: api.ForgeProvider(account.Provider),
+		Login:    account.Login,
+		UserId:   account.UserID,
+	}, nil
+}
+
+func (h *Handler) AddForgeRepository(ctx context.Context, request api.AddForgeRepositoryRequestObject) (api.AddForgeRepositoryResponseObject, error) {
+	repo, err := h.linker.AddRepository(ctx, domain.ForgeProvider(request.Body.Provider), request.Body.FullName)
+	if err != nil {
+		return nil, err
+	}
+	return api.AddForgeRepository201JSONResponse{
+		Provider:  api.ForgeProvider(repo.Provider),
+		FullName:  repo.FullName,
+		CreatedAt: repo.CreatedAt,
+	}, nil
+}
+
+// handlePullRequest передаёт разобранное событие форжа в общий слой ingest и пишет ответ.
+func handlePullRequest(log *slog.Logger, ingester Ingester, w http.ResponseWriter, r *http.Request, event *domain.ForgePullRequestEvent) {
+	res, err := ingester.HandlePullRequest(r.Context(), event)
+	if err != nil {
+		if status := e.WriteError(w, r, err); status >= http.StatusInternalServerError {
+			log.Error("failed to ingest forge event",
+				"provider", event.Provider, "repository", event.Repository, "number", event.Number, "err", err)
+		}
+		return
+	}
+	render.JSON(w, r, ingestResultTo(res))
+}
+
+func ingestResultTo(res *domain.IngestResult) api.IngestResult {
+	resp := api.IngestResult{Status: api.IngestStatusIgnored}
+	if res.Processed {
+		resp.Status = api.IngestStatusProcessed
+	}
+	if res.PullRequestID != "" {
+		resp.PullRequestId = &res.PullRequestID
+	}
+	if res.Reason != "" {
+		resp.Reason = &res.Reason
+	}
+	return resp
+}
@@ -0,0 +1,82 @@
+package out_of_office
+
+import (
+	"context"
+
+	"github.com/LeoUraltsev/PRReviewerService/api"
+	"github.com/LeoUraltsev/PRReviewerService/internal/domain"
+)
+
+type Service interface {
+	AddOutOfOffice(ctx context.Context, period *domain.OutOfOffice) (*domain.OutOfOffice, error)
+	GetOutOfOffice(ctx context.Context, userID string) ([]*domain.OutOfOffice, error)
+	DeleteOutOfOffice(ctx context.Context, id int64) error
+}
+
+type Handler struct {
+	service Service
+}
+
+func NewHandler(service Service) *Handler {
+	return &Handler{
+		service: service,
+	}
+}
+
+func (h *Handler) AddUserOutOfOffice(ctx context.Context, request api.AddUserOutOfOfficeRequestObject) (api.AddUserOutOfOfficeResponseObject, error) {
+	body := request.Body
+	period := &domain.OutOfOffice{
+		UserID:   body.UserId,
+		StartsAt: body.StartsAt.UTC(),
+		EndsAt:   body.EndsAt.UTC(),
+	}
+	if body.Reason != nil {
+		period.Reason = *body.Reason
+	}
+	if body.ReassignReviews != nil {
+		period.ReassignReviews = *body.ReassignReviews
+	}
+
+	saved, err := h.service.AddOutOfOffice(ctx, period)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.AddUserOutOfOffice201JSONResponse(domainTo(saved)), nil
+}
+
+func (h *Handler) GetUserOutOfOffice(ctx context.Context, request api.GetUserOutOfOfficeRequestObject) (api.GetUserOutOfOfficeResponseObject, error) {
+	periods, err := h.service.GetOutOfOffice(ctx, request.Params.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]api.OutOfOffice, len(periods))
+	for i, period := range periods {
+		res[i] = domainTo(period)
+	}
+	return api.GetUserOutOfOffice200JSONResponse{
+		UserId:  request.Params.UserId,
+		Periods: res,
+	}, nil
+}
+
+func (h *Handler) DeleteUserOutOfOffice(ctx context.Context, request api.DeleteUserOutOfOfficeRequestObject) (api.DeleteUserOutOfOfficeResponseObject, error) {
+	if err := h.service.DeleteOutOfOffice(ctx, request.Body.Id); err != nil {
+		return nil, err
+	}
+	return api.DeleteUserOutOfOffice204Response{}, nil
+}
+
+func domainTo(period *domain.OutOfOffice) api.OutOfOffice {
+	return api.OutOfOffice{
+		Id:              period.ID,
+		UserId:          period.UserID,
+		StartsAt:        period.StartsAt,
+		EndsAt:          period.EndsAt,
+		Reason:          period.Reason,
+		ReassignReviews: period.ReassignReviews,
+		ReassignedAt:    period.ReassignedAt,
+		CreatedAt:       period.CreatedAt,
+	}
+}
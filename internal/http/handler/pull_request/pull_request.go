@@ -2,186 +2,437 @@ package pull_request
 
 import (
 	"context"
-	"errors"
-	"net/http"
-	"time"
+	"crypto/subtle"
 
+	"github.com/LeoUraltsev/PRReviewerService/api"
 	"github.com/LeoUraltsev/PRReviewerService/internal/domain"
-	e "github.com/LeoUraltsev/PRReviewerService/internal/http/handler/helper/err"
-	"github.com/go-chi/render"
+	"github.com/LeoUraltsev/PRReviewerService/internal/http/handler/helper/etag"
 )
 
 type Saver interface {
-	SavePullRequest(ctx context.Context, prID string, prName string, authorID string) (*domain.PullRequest, error)
+	SavePullRequest(ctx context.Context, draft *domain.PullRequest) (*domain.PullRequest, error)
 }
 
 type Updater interface {
-	MergePullRequest(ctx context.Context, prID string) (*domain.PullRequest, error)
-	ReassignReviewerPullRequest(ctx context.Context, prID string, reviewerID string) (*domain.PullRequest, error)
+	MergePullRequest(ctx context.Context, prID string, version int64, override *domain.MergeOverride) (*domain.PullRequest, error)
+	ReassignReviewerPullRequest(ctx context.Context, prID string, version int64, reviewerID string) (*domain.PullRequest, string, error)
+	ReassignReviewerTo(ctx context.Context, prID string, version int64, reviewerID string, newReviewerID string) (*domain.PullRequest, string, error)
+	DeclineReview(ctx context.Context, prID string, version int64, reviewerID string, reason string) (*domain.PullRequest, string, error)
+	ReadyPullRequest(ctx context.Context, prID string, version int64) (*domain.PullRequest, error)
+	ClosePullRequest(ctx context.Context, prID string, version int64) (*domain.PullRequest, error)
+	ReopenPullRequest(ctx context.Context, prID string, version int64) (*domain.PullRequest, error)
+	AddReviewer(ctx context.Context, prID string, version int64, reviewerID string) (*domain.PullRequest, error)
+	RemoveReviewer(ctx context.Context, prID string, version int64, reviewerID string, backfill bool) (*domain.PullRequest, string, error)
+	UpdatePullRequest(ctx context.Context, prID string, version int64, update *domain.PullRequestUpdate) (*domain.PullRequest, error)
 }
 
-type createPRRequest struct {
-	PullRequestId   string `json:"pull_request_id"`
-	PullRequestName string `json:"pull_request_name"`
-	AuthorId        string `json:"author_id"`
+type Reviewer interface {
+	SubmitReview(ctx context.Context, prID string, version int64, reviewerID string, verdict domain.Verdict, comment string) (*domain.PullRequest, error)
 }
 
-type createPRResponse struct {
-	PullRequest pullRequest `json:"pr"`
+type Auditor interface {
+	GetPullRequestAudit(ctx context.Context, prID string) ([]*domain.AuditEntry, error)
 }
 
-type pullRequest struct {
-	PullRequestId     string   `json:"pull_request_id"`
-	PullRequestName   string   `json:"pull_request_name"`
-	AuthorId          string   `json:"author_id"`
-	Status            string   `json:"status"`
-	AssignedReviewers []string `json:"assigned_reviewers"`
-}
+// defaultOverrideActor — кем записывается override в журнал аудита, если actor не передан.
+const defaultOverrideActor = "admin"
 
-type mergedPRRequest struct {
-	PullRequestId string `json:"pull_request_id"`
+type Handler struct {
+	saver      Saver
+	updater    Updater
+	reviewer   Reviewer
+	auditor    Auditor
+	adminToken string
 }
 
-type mergedPRResponse struct {
-	PullRequest pullRequest `json:"pr"`
-	MergedAt    *time.Time  `json:"mergedAt"`
+// NewHandler создаёт обработчик PR. adminToken разрешает merge в обход политики;
+// если он пустой, override запрещён.
+func NewHandler(saver Saver, updater Updater, reviewer Reviewer, auditor Auditor, adminToken string) *Handler {
+	return &Handler{
+		saver:      saver,
+		updater:    updater,
+		reviewer:   reviewer,
+		auditor:    auditor,
+		adminToken: adminToken,
+	}
 }
 
-type reassignPRRequest struct {
-	PullRequestId string `json:"pull_request_id"`
-	OldReviewerId string `json:"old_reviewer_id"`
-}
+func (h *Handler) CreatePullRequest(ctx context.Context, request api.CreatePullRequestRequestObject) (api.CreatePullRequestResponseObject, error) {
+	draft := &domain.PullRequest{
+		ID:             request.Body.PullRequestId,
+		Name:           request.Body.PullRequestName,
+		AuthorID:       request.Body.AuthorId,
+		Labels:         request.Body.Labels,
+		ChangedFiles:   request.Body.ChangedFiles,
+		RequiredSkills: request.Body.RequiredSkills,
+	}
+	if request.Body.Description != nil {
+		draft.Description = *request.Body.Description
+	}
+	if request.Body.Priority != nil {
+		draft.Priority = domain.Priority(*request.Body.Priority)
+	}
+	if request.Body.RepositoryId != nil {
+		draft.RepositoryID = *request.Body.RepositoryId
+	}
+	draft.Size = sizeToDomain(request.Body.LinesAdded, request.Body.LinesRemoved, request.Body.FilesChanged)
+	if request.Body.Draft != nil && *request.Body.Draft {
+		draft.Status = domain.Draft
+	}
+
+	prDomain, err := h.saver.SavePullRequest(ctx, draft)
+	if err != nil {
+		return nil, err
+	}
 
-type reassignPRResponse struct {
-	PullRequest pullRequest `json:"pr"`
-	ReplacedBy  string      `json:"replaced_by"`
+	return api.CreatePullRequest201JSONResponse(domainToCreateResponse(prDomain)), nil
 }
 
-type Handler struct {
-	saver   Saver
-	updater Updater
+func (h *Handler) ReadyPullRequest(ctx context.Context, request api.ReadyPullRequestRequestObject) (api.ReadyPullRequestResponseObject, error) {
+	version, err := etag.ParseIfMatch(request.Params.IfMatch)
+	if err != nil {
+		return nil, err
+	}
+	prDomain, err := h.updater.ReadyPullRequest(ctx, request.Body.PullRequestId, version)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.ReadyPullRequest200JSONResponse{
+		Body:    domainToCreateResponse(prDomain),
+		Headers: api.ReadyPullRequest200ResponseHeaders{ETag: etag.Format(prDomain.Version)},
+	}, nil
 }
 
-func NewHandler(saver Saver, updater Updater) *Handler {
-	return &Handler{
-		saver:   saver,
-		updater: updater,
+func (h *Handler) ClosePullRequest(ctx context.Context, request api.ClosePullRequestRequestObject) (api.ClosePullRequestResponseObject, error) {
+	version, err := etag.ParseIfMatch(request.Params.IfMatch)
+	if err != nil {
+		return nil, err
+	}
+	prDomain, err := h.updater.ClosePullRequest(ctx, request.Body.PullRequestId, version)
+	if err != nil {
+		return nil, err
 	}
+
+	return api.ClosePullRequest200JSONResponse{
+		Body:    api.PullRequestResponse{Pr: domainToPullRequest(prDomain)},
+		Headers: api.ClosePullRequest200ResponseHeaders{ETag: etag.Format(prDomain.Version)},
+	}, nil
 }
 
-func (h *Handler) CreatePullRequest(w http.ResponseWriter, r *http.Request) {
-	defer r.Body.Close()
+func (h *Handler) ReopenPullRequest(ctx context.Context, request api.ReopenPullRequestRequestObject) (api.ReopenPullRequestResponseObject, error) {
+	version, err := etag.ParseIfMatch(request.Params.IfMatch)
+	if err != nil {
+		return nil, err
+	}
+	prDomain, err := h.updater.ReopenPullRequest(ctx, request.Body.PullRequestId, version)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.ReopenPullRequest200JSONResponse{
+		Body:    api.PullRequestResponse{Pr: domainToPullRequest(prDomain)},
+		Headers: api.ReopenPullRequest200ResponseHeaders{ETag: etag.Format(prDomain.Version)},
+	}, nil
+}
 
-	var req createPRRequest
-	err := render.DecodeJSON(r.Body, &req)
+func (h *Handler) UpdatePullRequest(ctx context.Context, request api.UpdatePullRequestRequestObject) (api.UpdatePullRequestResponseObject, error) {
+	version, err := etag.ParseIfMatch(request.Params.IfMatch)
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
-		render.JSON(w, r, e.IncorrectDataError())
-		return
+		return nil, err
 	}
+	body := request.Body
+	update := &domain.PullRequestUpdate{
+		Name:        body.PullRequestName,
+		AuthorID:    body.AuthorId,
+		Description: body.Description,
+		Labels:      body.Labels,
+	}
+	if body.Priority != nil {
+		update.Priority = (*domain.Priority)(body.Priority)
+	}
+	prDomain, err := h.updater.UpdatePullRequest(ctx, body.PullRequestId, version, update)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.UpdatePullRequest200JSONResponse{
+		Body:    api.PullRequestResponse{Pr: domainToPullRequest(prDomain)},
+		Headers: api.UpdatePullRequest200ResponseHeaders{ETag: etag.Format(prDomain.Version)},
+	}, nil
+}
 
-	prDomain, err := h.saver.SavePullRequest(r.Context(), req.PullRequestId, req.PullRequestName, req.AuthorId)
+func (h *Handler) MergePullRequest(ctx context.Context, request api.MergePullRequestRequestObject) (api.MergePullRequestResponseObject, error) {
+	version, err := etag.ParseIfMatch(request.Params.IfMatch)
 	if err != nil {
-		if errors.Is(err, domain.ErrPRNotFound) || errors.Is(err, domain.ErrUserNotFound) {
-			w.WriteHeader(http.StatusNotFound)
-			render.JSON(w, r, e.NotFoundError())
-			return
+		return nil, err
+	}
+	body := request.Body
+	var override *domain.MergeOverride
+	if body.Override != nil && *body.Override {
+		if !h.verifyAdminToken(request.Params.XAdminToken) {
+			return nil, domain.ErrIncorrectAdminToken
 		}
-
-		if errors.Is(err, domain.ErrPRAlreadyExists) {
-			w.WriteHeader(http.StatusConflict)
-			render.JSON(w, r, e.PRExistsError())
-			return
+		override = &domain.MergeOverride{Actor: defaultOverrideActor}
+		if body.Actor != nil && *body.Actor != "" {
+			override.Actor = *body.Actor
+		}
+		if body.Reason != nil {
+			override.Reason = *body.Reason
 		}
-
-		w.WriteHeader(http.StatusInternalServerError)
-		render.JSON(w, r, e.InternalServerError())
-		return
 	}
 
-	w.WriteHeader(http.StatusCreated)
-	render.JSON(w, r, createPRResponse{
-		PullRequest: domainToPullRequest(prDomain),
-	})
+	prDomain, err := h.updater.MergePullRequest(ctx, body.PullRequestId, version, override)
+	if err != nil {
+		return nil, err
+	}
 
+	return api.MergePullRequest200JSONResponse{
+		Body:    api.PullRequestResponse{Pr: domainToPullRequest(prDomain)},
+		Headers: api.MergePullRequest200ResponseHeaders{ETag: etag.Format(prDomain.Version)},
+	}, nil
 }
 
-func (h *Handler) MergePullRequest(w http.ResponseWriter, r *http.Request) {
-	defer r.Body.Close()
+func (h *Handler) ReassignPullRequest(ctx context.Context, request api.ReassignPullRequestRequestObject) (api.ReassignPullRequestResponseObject, error) {
+	version, err := etag.ParseIfMatch(request.Params.IfMatch)
+	if err != nil {
+		return nil, err
+	}
+	body := request.Body
+	var (
+		prDomain   *domain.PullRequest
+		replacedBy string
+	)
+	if body.NewReviewerId != nil {
+		prDomain, replacedBy, err = h.updater.ReassignReviewerTo(ctx, body.PullRequestId, version, body.OldReviewerId, *body.NewReviewerId)
+	} else {
+		prDomain, replacedBy, err = h.updater.ReassignReviewerPullRequest(ctx, body.PullRequestId, version, body.OldReviewerId)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return api.ReassignPullRequest200JSONResponse{
+		Body: api.ReassignPullRequestResponse{
+			Pr:         domainToPullRequest(prDomain),
+			ReplacedBy: replacedBy,
+		},
+		Headers: api.ReassignPullRequest200ResponseHeaders{ETag: etag.Format(prDomain.Version)},
+	}, nil
+}
 
-	var req mergedPRRequest
-	err := render.DecodeJSON(r.Body, &req)
+func (h *Handler) AddPullRequestReviewer(ctx context.Context, request api.AddPullRequestReviewerRequestObject) (api.AddPullRequestReviewerResponseObject, error) {
+	version, err := etag.ParseIfMatch(request.Params.IfMatch)
+	if err != nil {
+		return nil, err
+	}
+	prDomain, err := h.updater.AddReviewer(ctx, request.Body.PullRequestId, version, request.Body.ReviewerId)
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
-		render.JSON(w, r, e.IncorrectDataError())
-		return
+		return nil, err
 	}
 
-	prDomain, err := h.updater.MergePullRequest(r.Context(), req.PullRequestId)
+	return api.AddPullRequestReviewer200JSONResponse{
+		Body:    api.PullRequestResponse{Pr: domainToPullRequest(prDomain)},
+		Headers: api.AddPullRequestReviewer200ResponseHeaders{ETag: etag.Format(prDomain.Version)},
+	}, nil
+}
+
+func (h *Handler) RemovePullRequestReviewer(ctx context.Context, request api.RemovePullRequestReviewerRequestObject) (api.RemovePullRequestReviewerResponseObject, error) {
+	version, err := etag.ParseIfMatch(request.Params.IfMatch)
 	if err != nil {
-		if errors.Is(err, domain.ErrPRNotFound) {
-			w.WriteHeader(http.StatusNotFound)
-			render.JSON(w, r, e.NotFoundError())
-			return
-		}
+		return nil, err
+	}
+	body := request.Body
+	backfill := body.Backfill != nil && *body.Backfill
+	prDomain, backfilledBy, err := h.updater.RemoveReviewer(ctx, body.PullRequestId, version, body.ReviewerId, backfill)
+	if err != nil {
+		return nil, err
+	}
 
-		w.WriteHeader(http.StatusInternalServerError)
-		return
+	resp := api.RemovePullRequestReviewer200JSONResponse{
+		Body:    api.RemoveReviewerResponse{Pr: domainToPullRequest(prDomain)},
+		Headers: api.RemovePullRequestReviewer200ResponseHeaders{ETag: etag.Format(prDomain.Version)},
+	}
+	if backfilledBy != "" {
+		resp.Body.BackfilledBy = &backfilledBy
+	}
+	return resp, nil
+}
+
+func (h *Handler) DeclinePullRequestReview(ctx context.Context, request api.DeclinePullRequestReviewRequestObject) (api.DeclinePullRequestReviewResponseObject, error) {
+	version, err := etag.ParseIfMatch(request.Params.IfMatch)
+	if err != nil {
+		return nil, err
+	}
+	body := request.Body
+	prDomain, replacedBy, err := h.updater.DeclineReview(ctx, body.PullRequestId, version, body.ReviewerId, body.Reason)
+	if err != nil {
+		return nil, err
 	}
 
-	mergedAt := prDomain.MergedAt
-	pr := domainToPullRequest(prDomain)
-	w.WriteHeader(http.StatusOK)
-	render.JSON(w, r, mergedPRResponse{
-		PullRequest: pr,
-		MergedAt:    mergedAt,
-	})
+	resp := api.DeclinePullRequestReview200JSONResponse{
+		Body:    api.DeclineReviewResponse{Pr: domainToPullRequest(prDomain)},
+		Headers: api.DeclinePullRequestReview200ResponseHeaders{ETag: etag.Format(prDomain.Version)},
+	}
+	if replacedBy != "" {
+		resp.Body.ReplacedBy = &replacedBy
+	}
+	return resp, nil
 }
 
-func (h *Handler) ReassignPullRequest(w http.ResponseWriter, r *http.Request) {
-	defer r.Body.Close()
+func (h *Handler) SubmitPullRequestReview(ctx context.Context, request api.SubmitPullRequestReviewRequestObject) (api.SubmitPullRequestReviewResponseObject, error) {
+	version, err := etag.ParseIfMatch(request.Params.IfMatch)
+	if err != nil {
+		return nil, err
+	}
+	body := request.Body
+	comment := ""
+	if body.Comment != nil {
+		comment = *body.Comment
+	}
 
-	var req reassignPRRequest
-	err := render.DecodeJSON(r.Body, &req)
+	prDomain, err := h.reviewer.SubmitReview(ctx, body.PullRequestId, version, body.ReviewerId, domain.Verdict(body.Verdict), comment)
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
-		render.JSON(w, r, e.IncorrectDataError())
-		return
+		return nil, err
 	}
 
-	prDomain, err := h.updater.ReassignReviewerPullRequest(r.Context(), req.PullRequestId, req.OldReviewerId)
+	return api.SubmitPullRequestReview200JSONResponse{
+		Body:    api.PullRequestResponse{Pr: domainToPullRequest(prDomain)},
+		Headers: api.SubmitPullRequestReview200ResponseHeaders{ETag: etag.Format(prDomain.Version)},
+	}, nil
+}
+
+func (h *Handler) GetPullRequestAudit(ctx context.Context, request api.GetPullRequestAuditRequestObject) (api.GetPullRequestAuditResponseObject, error) {
+	entries, err := h.auditor.GetPullRequestAudit(ctx, request.Params.PullRequestId)
 	if err != nil {
-		if errors.Is(err, domain.ErrPRNotFound) {
-			w.WriteHeader(http.StatusNotFound)
-			render.JSON(w, r, e.NotFoundError())
-			return
-		}
+		return nil, err
+	}
 
-		if errors.Is(err, domain.ErrReassignPRMerged) {
-			w.WriteHeader(http.StatusConflict)
-			render.JSON(w, r, e.PRMergedError())
-			return
-		}
+	res := make([]api.AuditEntry, len(entries))
+	for i, e := range entries {
+		res[i] = domainToAuditEntry(e)
+	}
+	return api.GetPullRequestAudit200JSONResponse{
+		PullRequestId: request.Params.PullRequestId,
+		Entries:       res,
+	}, nil
+}
 
-		w.WriteHeader(http.StatusInternalServerError)
-		render.JSON(w, r, e.InternalServerError())
-		return
+func (h *Handler) verifyAdminToken(got *string) bool {
+	if h.adminToken == "" || got == nil {
+		return false
 	}
+	return subtle.ConstantTimeCompare([]byte(h.adminToken), []byte(*got)) == 1
+}
 
-	pr := domainToPullRequest(prDomain)
-	w.WriteHeader(http.StatusOK)
-	render.JSON(w, r, reassignPRResponse{
-		PullRequest: pr,
-		ReplacedBy:  req.OldReviewerId,
-	})
+func domainToAuditEntry(e *domain.AuditEntry) api.AuditEntry {
+	details := make([]api.FieldError, len(e.Details))
+	for i, d := range e.Details {
+		details[i] = api.FieldError{Field: d.Field, Message: d.Message}
+	}
+	return api.AuditEntry{
+		Id:        e.ID,
+		Action:    api.AuditEntryAction(e.Action),
+		Actor:     e.Actor,
+		Reason:    e.Reason,
+		Details:   details,
+		CreatedAt: e.CreatedAt,
+	}
+}
+
+func domainToCreateResponse(pr *domain.PullRequest) api.CreatePullRequestResponse {
+	assignments := make([]api.ReviewerAssignment, len(pr.Assignments))
+	for i, a := range pr.Assignments {
+		assignments[i] = domainToAssignment(a)
+	}
+	return api.CreatePullRequestResponse{
+		Pr:          domainToPullRequest(pr),
+		Assignments: assignments,
+	}
 }
 
-func domainToPullRequest(pr *domain.PullRequest) pullRequest {
-	return pullRequest{
+func domainToPullRequest(pr *domain.PullRequest) api.PullRequest {
+	createdAt := pr.CreatedAt
+	var repositoryID *string
+	if pr.RepositoryID != "" {
+		repositoryID = &pr.RepositoryID
+	}
+	res := api.PullRequest{
 		PullRequestId:     pr.ID,
 		PullRequestName:   pr.Name,
 		AuthorId:          pr.AuthorID,
-		Status:            pr.Status.String(),
+		Description:       &pr.Description,
+		Labels:            pr.Labels,
+		Priority:          api.PullRequestPriority(pr.Priority),
+		Status:            api.PullRequestStatus(pr.Status),
+		Version:           &pr.Version,
+		RepositoryId:      repositoryID,
+		ChangedFiles:      pr.ChangedFiles,
+		RequiredSkills:    pr.RequiredSkills,
+		MissingSkills:     pr.MissingSkills,
+		ReviewDueAt:       pr.ReviewDueAt,
+		NeedMoreReviewers: &pr.NeedMoreReviewers,
 		AssignedReviewers: pr.AssignedReviewers,
+		Reviews:           domainToReviews(pr.Reviews),
+		CreatedAt:         &createdAt,
+		MergedAt:          pr.MergedAt,
+	}
+	if size := pr.Size; size != nil {
+		bucket := api.PullRequestSize(size.Bucket())
+		res.LinesAdded, res.LinesRemoved, res.FilesChanged = &size.LinesAdded, &size.LinesRemoved, &size.FilesChanged
+		res.Size = &bucket
+	}
+	if pr.ReviewersCount > 0 {
+		res.ReviewersCount = &pr.ReviewersCount
+	}
+	return res
+}
+
+// sizeToDomain собирает объём изменений PR; не переданные счётчики считаются нулевыми.
+// Если не передан ни один, объём неизвестен.
+func sizeToDomain(linesAdded, linesRemoved, filesChanged *int) *domain.PullRequestSize {
+	if linesAdded == nil && linesRemoved == nil && filesChanged == nil {
+		return nil
+	}
+	var size domain.PullRequestSize
+	if linesAdded != nil {
+		size.LinesAdded = *linesAdded
+	}
+	if linesRemoved != nil {
+		size.LinesRemoved = *linesRemoved
+	}
+	if filesChanged != nil {
+		size.FilesChanged = *filesChanged
+	}
+	return &size
+}
+
+func domainToReviews(reviews []domain.Review) []api.Review {
+	res := make([]api.Review, len(reviews))
+	for i, r := range reviews {
+		res[i] = api.Review{
+			ReviewerId:  r.ReviewerID,
+			State:       api.ReviewState(r.Verdict),
+			Comment:     r.Comment,
+			AssignedAt:  r.AssignedAt,
+			SubmittedAt: r.SubmittedAt,
+		}
+	}
+	return res
+}
+
+func domainToAssignment(a domain.ReviewerAssignment) api.ReviewerAssignment {
+	res := api.ReviewerAssignment{
+		UserId: a.UserID,
+		Source: api.ReviewerAssignmentSource(a.Source),
+		Skills: a.Skills,
+	}
+	if a.Source == domain.AssignmentCodeOwners {
+		res.Rule = &api.CodeOwnersRuleMatch{
+			Line:    a.Line,
+			Pattern: a.Pattern,
+			Owner:   a.Owner,
+		}
 	}
+	return res
 }
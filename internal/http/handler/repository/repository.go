@@ -0,0 +1,186 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/LeoUraltsev/PRReviewerService/api"
+	"github.com/LeoUraltsev/PRReviewerService/internal/domain"
+)
+
+type Saver interface {
+	CreateRepository(ctx context.Context, repo *domain.Repository) (*domain.Repository, error)
+	UpdateRepository(ctx context.Context, repo *domain.Repository) (*domain.Repository, error)
+	DeleteRepository(ctx context.Context, repositoryID string) error
+}
+
+type Getter interface {
+	GetRepository(ctx context.Context, repositoryID string) (*domain.Repository, error)
+	ListRepositories(ctx context.Context) ([]*domain.Repository, error)
+}
+
+type CodeOwners interface {
+	SetCodeOwners(ctx context.Context, repositoryID string, content string) (*domain.CodeOwnersFile, error)
+	GetCodeOwners(ctx context.Context, repositoryID string) (*domain.CodeOwnersFile, error)
+}
+
+type Handler struct {
+	saver      Saver
+	getter     Getter
+	codeOwners CodeOwners
+}
+
+func NewHandler(saver Saver, getter Getter, codeOwners CodeOwners) *Handler {
+	return &Handler{
+		saver:      saver,
+		getter:     getter,
+		codeOwners: codeOwners,
+	}
+}
+
+func (h *Handler) AddRepository(ctx context.Context, request api.AddRepositoryRequestObject) (api.AddRepositoryResponseObject, error) {
+	repo, err := h.saver.CreateRepository(ctx, requestToDomain(request.Body))
+	if err != nil {
+		return nil, err
+	}
+
+	return api.AddRepository201JSONResponse{
+		Repository: repositoryDomainTo(repo),
+	}, nil
+}
+
+func (h *Handler) GetRepository(ctx context.Context, request api.GetRepositoryRequestObject) (api.GetRepositoryResponseObject, error) {
+	repo, err := h.getter.GetRepository(ctx, request.Params.RepositoryId)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.GetRepository200JSONResponse{
+		Repository: repositoryDomainTo(repo),
+	}, nil
+}
+
+func (h *Handler) ListRepositories(ctx context.Context, _ api.ListRepositoriesRequestObject) (api.ListRepositoriesResponseObject, error) {
+	repos, err := h.getter.ListRepositories(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]api.Repository, len(repos))
+	for i, repo := range repos {
+		res[i] = repositoryDomainTo(repo)
+	}
+	return api.ListRepositories200JSONResponse{
+		Repositories: res,
+	}, nil
+}
+
+func (h *Handler) UpdateRepository(ctx context.Context, request api.UpdateRepositoryRequestObject) (api.UpdateRepositoryResponseObject, error) {
+	repo, err := h.saver.UpdateRepository(ctx, requestToDomain(request.Body))
+	if err != nil {
+		return nil, err
+	}
+
+	return api.UpdateRepository200JSONResponse{
+		Repository: repositoryDomainTo(repo),
+	}, nil
+}
+
+func (h *Handler) DeleteRepository(ctx context.Context, request api.DeleteRepositoryRequestObject) (api.DeleteRepositoryResponseObject, error) {
+	if err := h.saver.DeleteRepository(ctx, request.Body.RepositoryId); err != nil {
+		return nil, err
+	}
+	return api.DeleteRepository204Response{}, nil
+}
+
+func (h *Handler) SetRepositoryCodeowners(ctx context.Context, request api.SetRepositoryCodeownersRequestObject) (api.SetRepositoryCodeownersResponseObject, error) {
+	file, err := h.codeOwners.SetCodeOwners(ctx, request.Body.RepositoryId, request.Body.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.SetRepositoryCodeowners200JSONResponse(codeOwnersDomainTo(file)), nil
+}
+
+func (h *Handler) GetRepositoryCodeowners(ctx context.Context, request api.GetRepositoryCodeownersRequestObject) (api.GetRepositoryCodeownersResponseObject, error) {
+	file, err := h.codeOwners.GetCodeOwners(ctx, request.Params.RepositoryId)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.GetRepositoryCodeowners200JSONResponse(codeOwnersDomainTo(file)), nil
+}
+
+// requestToDomain переводит запрос в доменную модель; незаданные настройки принимают значения
+// по умолчанию из спецификации.
+func requestToDomain(body *api.RepositoryRequest) *domain.Repository {
+	repo := &domain.Repository{
+		ID:         body.RepositoryId,
+		OwnerTeams: body.OwnerTeams,
+		Reviewers:  body.Reviewers,
+		Settings: domain.RepositorySettings{
+			ReviewersCount:     domain.DefaultReviewersCount,
+			AuthorTeamFallback: true,
+		},
+	}
+	if body.Name != nil {
+		repo.Name = *body.Name
+	}
+	if s := body.Settings; s != nil {
+		if s.ReviewersCount != nil {
+			repo.Settings.ReviewersCount = *s.ReviewersCount
+		}
+		if s.AuthorTeamFallback != nil {
+			repo.Settings.AuthorTeamFallback = *s.AuthorTeamFallback
+		}
+		if p := s.MergePolicy; p != nil {
+			if p.RequiredApprovals != nil {
+				repo.Settings.MergePolicy.RequiredApprovals = *p.RequiredApprovals
+			}
+			if p.BlockOnChangesRequested != nil {
+				repo.Settings.MergePolicy.BlockOnChangesRequested = *p.BlockOnChangesRequested
+			}
+			if p.RequireFullStaff != nil {
+				repo.Settings.MergePolicy.RequireFullStaff = *p.RequireFullStaff
+			}
+		}
+	}
+	return repo
+}
+
+func repositoryDomainTo(repo *domain.Repository) api.Repository {
+	createdAt, updatedAt := repo.CreatedAt, repo.UpdatedAt
+	reviewersCount, fallback := repo.Settings.ReviewersCount, repo.Settings.AuthorTeamFallback
+	mergePolicy := repo.Settings.MergePolicy
+	ownerTeams, reviewers := repo.OwnerTeams, repo.Reviewers
+	if ownerTeams == nil {
+		ownerTeams = []string{}
+	}
+	if reviewers == nil {
+		reviewers = []string{}
+	}
+	return api.Repository{
+		RepositoryId: repo.ID,
+		Name:         repo.Name,
+		OwnerTeams:   ownerTeams,
+		Reviewers:    reviewers,
+		Settings: api.RepositorySettings{
+			ReviewersCount:     &reviewersCount,
+			AuthorTeamFallback: &fallback,
+			MergePolicy: &api.MergePolicy{
+				RequiredApprovals:       &mergePolicy.RequiredApprovals,
+				BlockOnChangesRequested: &mergePolicy.BlockOnChangesRequested,
+				RequireFullStaff:        &mergePolicy.RequireFullStaff,
+			},
+		},
+		CreatedAt: &createdAt,
+		UpdatedAt: &updatedAt,
+	}
+}
+
+func codeOwnersDomainTo(file *domain.CodeOwnersFile) api.CodeOwners {
+	return api.CodeOwners{
+		RepositoryId: file.RepositoryID,
+		Content:      file.Content,
+		UpdatedAt:    file.UpdatedAt,
+	}
+}
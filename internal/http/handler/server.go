@@ -0,0 +1,85 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/LeoUraltsev/PRReviewerService/api"
+	e "github.com/LeoUraltsev/PRReviewerService/internal/http/handler/helper/err"
+	"github.com/LeoUraltsev/PRReviewerService/internal/http/handler/integration"
+	"github.com/LeoUraltsev/PRReviewerService/internal/http/handler/out_of_office"
+	"github.com/LeoUraltsev/PRReviewerService/internal/http/handler/pull_request"
+	"github.com/LeoUraltsev/PRReviewerService/internal/http/handler/repository"
+	"github.com/LeoUraltsev/PRReviewerService/internal/http/handler/team"
+	"github.com/LeoUraltsev/PRReviewerService/internal/http/handler/user"
+	"github.com/LeoUraltsev/PRReviewerService/internal/http/handler/webhook"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+)
+
+type (
+	teamHandler        = team.Handler
+	userHandler        = user.Handler
+	pullRequestHandler = pull_request.Handler
+	webhookHandler     = webhook.Handler
+	integrationHandler = integration.Handler
+	repositoryHandler  = repository.Handler
+	outOfOfficeHandler = out_of_office.Handler
+)
+
+// Server собирает обработчики отдельных ресурсов в одну реализацию
+// сгенерированного по openapi.yml интерфейса api.StrictServerInterface.
+type Server struct {
+	*teamHandler
+	*userHandler
+	*pullRequestHandler
+	*webhookHandler
+	*integrationHandler
+	*repositoryHandler
+	*outOfOfficeHandler
+}
+
+var _ api.StrictServerInterface = (*Server)(nil)
+
+func NewServer(
+	team *team.Handler,
+	user *user.Handler,
+	pr *pull_request.Handler,
+	webhook *webhook.Handler,
+	integration *integration.Handler,
+	repository *repository.Handler,
+	outOfOffice *out_of_office.Handler,
+) *Server {
+	return &Server{
+		teamHandler:        team,
+		userHandler:        user,
+		pullRequestHandler: pr,
+		webhookHandler:     webhook,
+		integrationHandler: integration,
+		repositoryHandler:  repository,
+		outOfOfficeHandler: outOfOffice,
+	}
+}
+
+// Register регистрирует маршруты из спецификации на роутере r.
+func Register(log *slog.Logger, r chi.Router, server api.StrictServerInterface) http.Handler {
+	badRequest := func(w http.ResponseWriter, r *http.Request, err error) {
+		log.Debug("failed to decode request", "path", r.URL.Path, "err", err)
+		w.WriteHeader(http.StatusBadRequest)
+		render.JSON(w, r, e.IncorrectDataError())
+	}
+
+	strict := api.NewStrictHandlerWithOptions(server, nil, api.StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: badRequest,
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			if status := e.WriteError(w, r, err); status >= http.StatusInternalServerError {
+				log.Error("failed to handle request", "path", r.URL.Path, "err", err)
+			}
+		},
+	})
+
+	return api.HandlerWithOptions(strict, api.ChiServerOptions{
+		BaseRouter:       r,
+		ErrorHandlerFunc: badRequest,
+	})
+}
@@ -0,0 +1,327 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/LeoUraltsev/PRReviewerService/api"
+	"github.com/LeoUraltsev/PRReviewerService/internal/domain"
+	"github.com/LeoUraltsev/PRReviewerService/internal/http/handler/integration"
+	"github.com/LeoUraltsev/PRReviewerService/internal/http/handler/out_of_office"
+	"github.com/LeoUraltsev/PRReviewerService/internal/http/handler/pull_request"
+	"github.com/LeoUraltsev/PRReviewerService/internal/http/handler/repository"
+	"github.com/LeoUraltsev/PRReviewerService/internal/http/handler/team"
+	"github.com/LeoUraltsev/PRReviewerService/internal/http/handler/user"
+	"github.com/LeoUraltsev/PRReviewerService/internal/http/handler/webhook"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// failingService реализует все интерфейсы сервисов и всегда возвращает заданную ошибку.
+type failingService struct {
+	err error
+}
+
+func (s failingService) Save(context.Context, *domain.Team) error { return s.err }
+func (s failingService) Get(context.Context, string) (*domain.Team, error) {
+	return nil, s.err
+}
+func (s failingService) UpdateIsActive(context.Context, string, int64, bool) (*domain.User, error) {
+	return nil, s.err
+}
+func (s failingService) GetUserPullRequest(context.Context, string, domain.PullRequestFilter) ([]*domain.PullRequest, error) {
+	return nil, s.err
+}
+func (s failingService) SetTeamMaxOpenReviews(context.Context, string, int) (*domain.TeamCapacity, error) {
+	return nil, s.err
+}
+func (s failingService) GetTeamCapacity(context.Context, string) (*domain.TeamCapacity, error) {
+	return nil, s.err
+}
+func (s failingService) SetUserMaxOpenReviews(context.Context, string, int64, *int) (*domain.ReviewerCapacity, error) {
+	return nil, s.err
+}
+func (s failingService) SetUserSkills(context.Context, string, int64, []string) (*domain.UserSkills, error) {
+	return nil, s.err
+}
+func (s failingService) GetUserSkills(context.Context, string) (*domain.UserSkills, error) {
+	return nil, s.err
+}
+func (s failingService) SavePullRequest(context.Context, *domain.PullRequest) (*domain.PullRequest, error) {
+	return nil, s.err
+}
+func (s failingService) MergePullRequest(context.Context, string, int64, *domain.MergeOverride) (*domain.PullRequest, error) {
+	return nil, s.err
+}
+func (s failingService) SubmitReview(context.Context, string, int64, string, domain.Verdict, string) (*domain.PullRequest, error) {
+	return nil, s.err
+}
+func (s failingService) ReassignReviewerPullRequest(context.Context, string, int64, string) (*domain.PullRequest, string, error) {
+	return nil, "", s.err
+}
+
+func (s failingService) Subscribe(context.Context, string, []domain.EventType, string) (*domain.WebhookSubscription, error) {
+	return nil, s.err
+}
+func (s failingService) Unsubscribe(context.Context, string) error { return s.err }
+func (s failingService) GetDeliveries(context.Context, string, int) ([]*domain.WebhookDelivery, error) {
+	return nil, s.err
+}
+
+func (s failingService) LinkAccount(context.Context, *domain.ForgeAccount) (*domain.ForgeAccount, error) {
+	return nil, s.err
+}
+func (s failingService) AddRepository(context.Context, domain.ForgeProvider, string) (*domain.ForgeRepository, error) {
+	return nil, s.err
+}
+
+func (s failingService) CreateRepository(context.Context, *domain.Repository) (*domain.Repository, error) {
+	return nil, s.err
+}
+func (s failingService) UpdateRepository(context.Context, *domain.Repository) (*domain.Repository, error) {
+	return nil, s.err
+}
+func (s failingService) DeleteRepository(context.Context, string) error { return s.err }
+func (s failingService) GetRepository(context.Context, string) (*domain.Repository, error) {
+	return nil, s.err
+}
+func (s failingService) ListRepositories(context.Context) ([]*domain.Repository, error) {
+	return nil, s.err
+}
+func (s failingService) SetCodeOwners(context.Context, string, string) (*domain.CodeOwnersFile, error) {
+	return nil, s.err
+}
+func (s failingService) GetCodeOwners(context.Context, string) (*domain.CodeOwnersFile, error) {
+	return nil, s.err
+}
+
+func (s failingService) ReassignReviewerTo(context.Context, string, int64, string, string) (*domain.PullRequest, string, error) {
+	return nil, "", s.err
+}
+
+func (s failingService) DeclineReview(context.Context, string, int64, string, string) (*domain.PullRequest, string, error) {
+	return nil, "", s.err
+}
+
+func (s failingService) AddReviewer(context.Context, string, int64, string) (*domain.PullRequest, error) {
+	return nil, s.err
+}
+
+func (s failingService) RemoveReviewer(context.Context, string, int64, string, bool) (*domain.PullRequest, string, error) {
+	return nil, "", s.err
+}
+
+func (s failingService) UpdatePullRequest(context.Context, string, int64, *domain.PullRequestUpdate) (*domain.PullRequest, error) {
+	return nil, s.err
+}
+
+func (s failingService) ReadyPullRequest(context.Context, string, int64) (*domain.PullRequest, error) {
+	return nil, s.err
+}
+
+func (s failingService) ClosePullRequest(context.Context, string, int64) (*domain.PullRequest, error) {
+	return nil, s.err
+}
+
+func (s failingService) ReopenPullRequest(context.Context, string, int64) (*domain.PullRequest, error) {
+	return nil, s.err
+}
+
+func (s failingService) GetPullRequestAudit(context.Context, string) ([]*domain.AuditEntry, error) {
+	return nil, s.err
+}
+
+func (s failingService) SetTeamMergePolicy(context.Context, string, domain.MergePolicy) error {
+	return s.err
+}
+
+func (s failingService) GetTeamMergePolicy(context.Context, string) (domain.MergePolicy, error) {
+	return domain.MergePolicy{}, s.err
+}
+
+func (s failingService) SetTeamReviewRules(context.Context, string, []domain.ReviewRule) ([]domain.ReviewRule, error) {
+	return nil, s.err
+}
+
+func (s failingService) GetTeamReviewRules(context.Context, string) ([]domain.ReviewRule, error) {
+	return nil, s.err
+}
+
+func (s failingService) SetTeamWorkingHoursPolicy(context.Context, string, domain.WorkingHoursPolicy) error {
+	return s.err
+}
+func (s failingService) SetUserWorkingHours(context.Context, *domain.WorkingHours) (*domain.WorkingHours, error) {
+	return nil, s.err
+}
+func (s failingService) GetUserWorkingHours(context.Context, string) (*domain.WorkingHours, error) {
+	return nil, s.err
+}
+func (s failingService) DeleteUserWorkingHours(context.Context, string) error { return s.err }
+
+func (s failingService) AddOutOfOffice(context.Context, *domain.OutOfOffice) (*domain.OutOfOffice, error) {
+	return nil, s.err
+}
+func (s failingService) GetOutOfOffice(context.Context, string) ([]*domain.OutOfOffice, error) {
+	return nil, s.err
+}
+func (s failingService) DeleteOutOfOffice(context.Context, int64) error { return s.err }
+
+func TestServer_Errors(t *testing.T) {
+	type request struct {
+		method string
+		target string
+		body   string
+	}
+	var (
+		addTeam    = request{http.MethodPost, "/team/add", `{"team_name":"backend","members":[]}`}
+		getTeam    = request{http.MethodGet, "/team/get?team_name=backend", ""}
+		setActive  = request{http.MethodPost, "/users/setIsActive", `{"user_id":"u1","is_active":false}`}
+		getReview  = request{http.MethodGet, "/users/getReview?user_id=u1", ""}
+		teamLimit  = request{http.MethodPost, "/team/setMaxOpenReviews", `{"team_name":"backend","max_open_reviews":4}`}
+		capacity   = request{http.MethodGet, "/team/getCapacity?team_name=backend", ""}
+		userLimit  = request{http.MethodPost, "/users/setMaxOpenReviews", `{"user_id":"u1","max_open_reviews":null}`}
+		setSkills  = request{http.MethodPost, "/users/setSkills", `{"user_id":"u1","skills":["go"]}`}
+		getSkills  = request{http.MethodGet, "/users/getSkills?user_id=u1", ""}
+		createPR   = request{http.MethodPost, "/pullRequest/create", `{"pull_request_id":"pr-1","pull_request_name":"x","author_id":"u1"}`}
+		mergePR    = request{http.MethodPost, "/pullRequest/merge", `{"pull_request_id":"pr-1"}`}
+		reassignPR = request{http.MethodPost, "/pullRequest/reassign", `{"pull_request_id":"pr-1","old_reviewer_id":"u2"}`}
+		reviewPR   = request{http.MethodPost, "/pullRequest/review", `{"pull_request_id":"pr-1","reviewer_id":"u2","verdict":"APPROVED"}`}
+		subscribe  = request{http.MethodPost, "/webhooks/subscribe", `{"url":"http://localhost/hook","events":["pr.created"]}`}
+		unsub      = request{http.MethodPost, "/webhooks/unsubscribe", `{"subscription_id":"s1"}`}
+		deliveries = request{http.MethodGet, "/webhooks/getDeliveries?subscription_id=s1", ""}
+		linkAcc    = request{http.MethodPost, "/integrations/accounts/link", `{"provider":"github","login":"alice","user_id":"u1"}`}
+		addRepo    = request{http.MethodPost, "/integrations/repositories/add", `{"provider":"gitlab","full_name":"org/service"}`}
+		createRepo = request{http.MethodPost, "/repositories/add", `{"repository_id":"billing","owner_teams":["payments"]}`}
+		updateRepo = request{http.MethodPost, "/repositories/update", `{"repository_id":"billing","reviewers":["u9"]}`}
+		getRepo    = request{http.MethodGet, "/repositories/get?repository_id=billing", ""}
+		deleteRepo = request{http.MethodPost, "/repositories/delete", `{"repository_id":"billing"}`}
+		setOwners  = request{http.MethodPost, "/repositories/setCodeowners", `{"repository_id":"backend","content":"*.go backend"}`}
+		getOwners  = request{http.MethodGet, "/repositories/getCodeowners?repository_id=backend", ""}
+		teamPolicy = request{http.MethodPost, "/team/setWorkingHoursPolicy", `{"team_name":"backend","working_hours_policy":"require"}`}
+		setHours   = request{http.MethodPost, "/users/setWorkingHours", `{"user_id":"u1","time_zone":"Europe/Berlin","days":["mon"],"start":"09:00","end":"18:00"}`}
+		getHours   = request{http.MethodGet, "/users/getWorkingHours?user_id=u1", ""}
+		addOOO     = request{http.MethodPost, "/users/addOutOfOffice", `{"user_id":"u1","starts_at":"2026-08-01T00:00:00Z","ends_at":"2026-08-15T00:00:00Z"}`}
+		getOOO     = request{http.MethodGet, "/users/getOutOfOffice?user_id=u1", ""}
+		deleteOOO  = request{http.MethodPost, "/users/deleteOutOfOffice", `{"id":1}`}
+		internal   = errors.New("db is down")
+	)
+
+	tests := []struct {
+		name       string
+		req        request
+		err        error
+		wantStatus int
+		wantCode   api.ErrorCode
+	}{
+		{"add team exists", addTeam, domain.ErrTeamExists, http.StatusBadRequest, api.ErrorCodeTeamExists},
+		{"add team internal", addTeam, internal, http.StatusInternalServerError, api.ErrorCodeInternalServerError},
+		{"add team bad json", request{http.MethodPost, "/team/add", `{`}, nil, http.StatusBadRequest, api.ErrorCodeIncorrectData},
+		{"get team not found", getTeam, domain.ErrTeamNotFound, http.StatusNotFound, api.ErrorCodeNotFound},
+		{"get team internal", getTeam, internal, http.StatusInternalServerError, api.ErrorCodeInternalServerError},
+		{"get team without name", request{http.MethodGet, "/team/get", ""}, nil, http.StatusBadRequest, api.ErrorCodeIncorrectData},
+		{"set active not found", setActive, domain.ErrUserNotFound, http.StatusNotFound, api.ErrorCodeNotFound},
+		{"set active unauthorized", setActive, domain.ErrIncorrectAdminToken, http.StatusUnauthorized, api.ErrorCodeUnauthorized},
+		{"set active version mismatch", setActive, domain.ErrVersionMismatch, http.StatusPreconditionFailed, api.ErrorCodeVersionMismatch},
+		{"set active internal", setActive, internal, http.StatusInternalServerError, api.ErrorCodeInternalServerError},
+		{"get review not found", getReview, domain.ErrUserNotFound, http.StatusNotFound, api.ErrorCodeNotFound},
+		{"get review internal", getReview, internal, http.StatusInternalServerError, api.ErrorCodeInternalServerError},
+		{"set team limit not found", teamLimit, domain.ErrTeamNotFound, http.StatusNotFound, api.ErrorCodeNotFound},
+		{"get capacity not found", capacity, domain.ErrTeamNotFound, http.StatusNotFound, api.ErrorCodeNotFound},
+		{"set user limit not found", userLimit, domain.ErrUserNotFound, http.StatusNotFound, api.ErrorCodeNotFound},
+		{"set skills not found", setSkills, domain.ErrUserNotFound, http.StatusNotFound, api.ErrorCodeNotFound},
+		{"set skills invalid", setSkills, domain.NewError(domain.CodeIncorrectData, `invalid skill "a b"`), http.StatusBadRequest, api.ErrorCodeIncorrectData},
+		{"get skills not found", getSkills, domain.ErrUserNotFound, http.StatusNotFound, api.ErrorCodeNotFound},
+		{"create pr author not found", createPR, domain.ErrUserNotFound, http.StatusNotFound, api.ErrorCodeNotFound},
+		{"create pr exists", createPR, domain.ErrPRAlreadyExists, http.StatusConflict, api.ErrorCodePRExists},
+		{"create pr internal", createPR, internal, http.StatusInternalServerError, api.ErrorCodeInternalServerError},
+		{"merge pr not found", mergePR, domain.ErrPRNotFound, http.StatusNotFound, api.ErrorCodeNotFound},
+		{"merge pr internal", mergePR, internal, http.StatusInternalServerError, api.ErrorCodeInternalServerError},
+		{"merge pr blocked", mergePR, domain.NewMergeBlockedError([]domain.ErrorDetail{{Field: "approvals", Message: "2 approvals required, 0 given"}}), http.StatusConflict, api.ErrorCodeMergeBlocked},
+		{"merge override without token", request{http.MethodPost, "/pullRequest/merge", `{"pull_request_id":"pr-1","override":true,"reason":"hotfix"}`}, nil, http.StatusUnauthorized, api.ErrorCodeUnauthorized},
+		{"reassign to ineligible", request{http.MethodPost, "/pullRequest/reassign", `{"pull_request_id":"pr-1","old_reviewer_id":"u2","new_reviewer_id":"u6"}`}, domain.ErrReviewerOutsidePool, http.StatusConflict, api.ErrorCodeReviewerNotEligible},
+		{"decline not assigned", request{http.MethodPost, "/pullRequest/decline", `{"pull_request_id":"pr-1","reviewer_id":"u5","reason":"busy"}`}, domain.ErrNotAssigned, http.StatusConflict, api.ErrorCodeNotAssigned},
+		{"decline without reason", request{http.MethodPost, "/pullRequest/decline", `{"pull_request_id":"pr-1","reviewer_id":"u2","reason":" "}`}, domain.ErrDeclineReasonRequired, http.StatusBadRequest, api.ErrorCodeIncorrectData},
+		{"add reviewer not eligible", request{http.MethodPost, "/pullRequest/addReviewer", `{"pull_request_id":"pr-1","reviewer_id":"u5"}`}, domain.ErrReviewerAtCapacity, http.StatusConflict, api.ErrorCodeReviewerNotEligible},
+		{"add reviewer already assigned", request{http.MethodPost, "/pullRequest/addReviewer", `{"pull_request_id":"pr-1","reviewer_id":"u2"}`}, domain.ErrAlreadyAssigned, http.StatusConflict, api.ErrorCodeAlreadyAssigned},
+		{"remove reviewer not assigned", request{http.MethodPost, "/pullRequest/removeReviewer", `{"pull_request_id":"pr-1","reviewer_id":"u5","backfill":true}`}, domain.ErrNotAssigned, http.StatusConflict, api.ErrorCodeNotAssigned},
+		{"update pr version mismatch", request{http.MethodPatch, "/pullRequest/update", `{"pull_request_id":"pr-1","pull_request_name":"Rename"}`}, domain.ErrVersionMismatch, http.StatusPreconditionFailed, api.ErrorCodeVersionMismatch},
+		{"update pr author of merged", request{http.MethodPatch, "/pullRequest/update", `{"pull_request_id":"pr-1","author_id":"u2"}`}, domain.ErrPRNotOpen, http.StatusConflict, api.ErrorCodePRNotOpen},
+		{"ready not draft", request{http.MethodPost, "/pullRequest/ready", `{"pull_request_id":"pr-1"}`}, domain.ErrPRNotDraft, http.StatusConflict, api.ErrorCodeInvalidTransition},
+		{"close merged", request{http.MethodPost, "/pullRequest/close", `{"pull_request_id":"pr-1"}`}, domain.NewTransitionError(domain.Merged, domain.Closed), http.StatusConflict, api.ErrorCodeInvalidTransition},
+		{"reopen not found", request{http.MethodPost, "/pullRequest/reopen", `{"pull_request_id":"pr-1"}`}, domain.ErrPRNotFound, http.StatusNotFound, api.ErrorCodeNotFound},
+		{"review closed pr", reviewPR, domain.ErrPRNotOpen, http.StatusConflict, api.ErrorCodePRNotOpen},
+		{"audit pr not found", request{http.MethodGet, "/pullRequest/getAudit?pull_request_id=pr-1", ""}, domain.ErrPRNotFound, http.StatusNotFound, api.ErrorCodeNotFound},
+		{"set merge policy not found", request{http.MethodPost, "/team/setMergePolicy", `{"team_name":"backend","merge_policy":{"required_approvals":2}}`}, domain.ErrTeamNotFound, http.StatusNotFound, api.ErrorCodeNotFound},
+		{"get merge policy not found", request{http.MethodGet, "/team/getMergePolicy?team_name=backend", ""}, domain.ErrTeamNotFound, http.StatusNotFound, api.ErrorCodeNotFound},
+		{"reassign pr not found", reassignPR, domain.ErrPRNotFound, http.StatusNotFound, api.ErrorCodeNotFound},
+		{"reassign reviewer not found", reassignPR, domain.ErrUserNotFound, http.StatusNotFound, api.ErrorCodeNotFound},
+		{"reassign merged", reassignPR, domain.ErrReassignPRMerged, http.StatusConflict, api.ErrorCodePRMerged},
+		{"reassign not assigned", reassignPR, domain.ErrNotAssigned, http.StatusConflict, api.ErrorCodeNotAssigned},
+		{"reassign no candidate", reassignPR, domain.ErrNoCandidate, http.StatusConflict, api.ErrorCodeNoCandidate},
+		{"reassign internal", reassignPR, internal, http.StatusInternalServerError, api.ErrorCodeInternalServerError},
+		{"review pr not found", reviewPR, domain.ErrPRNotFound, http.StatusNotFound, api.ErrorCodeNotFound},
+		{"review merged", reviewPR, domain.ErrReviewPRMerged, http.StatusConflict, api.ErrorCodePRMerged},
+		{"review not assigned", reviewPR, domain.ErrNotAssigned, http.StatusConflict, api.ErrorCodeNotAssigned},
+		{"review bad json", request{http.MethodPost, "/pullRequest/review", `{"pull_request_id":1}`}, nil, http.StatusBadRequest, api.ErrorCodeIncorrectData},
+		{"subscribe invalid url", subscribe, domain.ErrInvalidWebhookURL, http.StatusBadRequest, api.ErrorCodeIncorrectData},
+		{"subscribe internal", subscribe, internal, http.StatusInternalServerError, api.ErrorCodeInternalServerError},
+		{"unsubscribe not found", unsub, domain.ErrWebhookNotFound, http.StatusNotFound, api.ErrorCodeNotFound},
+		{"deliveries not found", deliveries, domain.ErrWebhookNotFound, http.StatusNotFound, api.ErrorCodeNotFound},
+		{"deliveries bad limit", request{http.MethodGet, "/webhooks/getDeliveries?subscription_id=s1&limit=x", ""}, nil, http.StatusBadRequest, api.ErrorCodeIncorrectData},
+		{"link account user not found", linkAcc, domain.ErrUserNotFound, http.StatusNotFound, api.ErrorCodeNotFound},
+		{"add repository internal", addRepo, internal, http.StatusInternalServerError, api.ErrorCodeInternalServerError},
+		{"add repository exists", createRepo, domain.ErrRepositoryExists, http.StatusConflict, api.ErrorCodeRepositoryExists},
+		{"add repository team not found", createRepo, domain.ErrTeamNotFound, http.StatusNotFound, api.ErrorCodeNotFound},
+		{"update repository not found", updateRepo, domain.ErrRepositoryNotFound, http.StatusNotFound, api.ErrorCodeNotFound},
+		{"get repository not found", getRepo, domain.ErrRepositoryNotFound, http.StatusNotFound, api.ErrorCodeNotFound},
+		{"get repository without id", request{http.MethodGet, "/repositories/get", ""}, nil, http.StatusBadRequest, api.ErrorCodeIncorrectData},
+		{"delete repository internal", deleteRepo, internal, http.StatusInternalServerError, api.ErrorCodeInternalServerError},
+		{"set codeowners invalid", setOwners, domain.NewError(domain.CodeIncorrectData, "invalid CODEOWNERS line 1"), http.StatusBadRequest, api.ErrorCodeIncorrectData},
+		{"get codeowners not found", getOwners, domain.ErrCodeOwnersNotFound, http.StatusNotFound, api.ErrorCodeNotFound},
+		{"set team policy not found", teamPolicy, domain.ErrTeamNotFound, http.StatusNotFound, api.ErrorCodeNotFound},
+		{"set team policy unknown", teamPolicy, domain.WorkingHoursPolicy("always").Validate(), http.StatusBadRequest, api.ErrorCodeIncorrectData},
+		{"set working hours user not found", setHours, domain.ErrUserNotFound, http.StatusNotFound, api.ErrorCodeNotFound},
+		{"set working hours bad zone", setHours, domain.NewError(domain.CodeIncorrectData, `unknown time zone "Mars/Base"`), http.StatusBadRequest, api.ErrorCodeIncorrectData},
+		{"set working hours bad time", request{http.MethodPost, "/users/setWorkingHours", `{"user_id":"u1","time_zone":"UTC","days":["mon"],"start":"9am","end":"18:00"}`}, nil, http.StatusBadRequest, api.ErrorCodeIncorrectData},
+		{"get working hours not set", getHours, domain.ErrWorkingHoursNotFound, http.StatusNotFound, api.ErrorCodeNotFound},
+		{"add out of office user not found", addOOO, domain.ErrUserNotFound, http.StatusNotFound, api.ErrorCodeNotFound},
+		{"add out of office invalid", addOOO, domain.NewError(domain.CodeIncorrectData, "ends_at must be after starts_at"), http.StatusBadRequest, api.ErrorCodeIncorrectData},
+		{"add out of office bad time", request{http.MethodPost, "/users/addOutOfOffice", `{"user_id":"u1","starts_at":"tomorrow","ends_at":"2026-08-15T00:00:00Z"}`}, nil, http.StatusBadRequest, api.ErrorCodeIncorrectData},
+		{"get out of office not found", getOOO, domain.ErrUserNotFound, http.StatusNotFound, api.ErrorCodeNotFound},
+		{"delete out of office not found", deleteOOO, domain.ErrOutOfOfficeNotFound, http.StatusNotFound, api.ErrorCodeNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := failingService{err: tt.err}
+			server := NewServer(
+				team.NewHandler(svc, svc, svc, svc, svc, svc),
+				user.NewHandler(svc, svc, svc, svc, svc),
+				pull_request.NewHandler(svc, svc, svc, svc, "secret"),
+				webhook.NewHandler(svc, svc),
+				integration.NewHandler(svc),
+				repository.NewHandler(svc, svc, svc),
+				out_of_office.NewHandler(svc),
+			)
+			r := chi.NewRouter()
+			Register(slog.New(slog.NewTextHandler(io.Discard, nil)), r, server)
+
+			req := httptest.NewRequest(tt.req.method, tt.req.target, strings.NewReader(tt.req.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			var resp api.ErrorResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+			assert.Equal(t, tt.wantCode, resp.Error.Code)
+		})
+	}
+}
@@ -2,12 +2,10 @@ package team
 
 import (
 	"context"
-	"errors"
-	"net/http"
+	"time"
 
+	"github.com/LeoUraltsev/PRReviewerService/api"
 	"github.com/LeoUraltsev/PRReviewerService/internal/domain"
-	e "github.com/LeoUraltsev/PRReviewerService/internal/http/handler/helper/err"
-	"github.com/go-chi/render"
 )
 
 type Saver interface {
@@ -18,96 +16,234 @@ type Getter interface {
 	Get(ctx context.Context, teamName string) (*domain.Team, error)
 }
 
-type teamMember struct {
-	UserId   string `json:"user_id"`
-	Username string `json:"username"`
-	IsActive bool   `json:"is_active"`
+type Capacity interface {
+	SetTeamMaxOpenReviews(ctx context.Context, teamName string, limit int) (*domain.TeamCapacity, error)
+	GetTeamCapacity(ctx context.Context, teamName string) (*domain.TeamCapacity, error)
 }
-type team struct {
-	TeamName string       `json:"team_name"`
-	Members  []teamMember `json:"members"`
+
+type WorkingHoursPolicy interface {
+	SetTeamWorkingHoursPolicy(ctx context.Context, teamName string, policy domain.WorkingHoursPolicy) error
+}
+
+type MergePolicy interface {
+	SetTeamMergePolicy(ctx context.Context, teamName string, policy domain.MergePolicy) error
+	GetTeamMergePolicy(ctx context.Context, teamName string) (domain.MergePolicy, error)
 }
 
-type responseAddTeam struct {
-	Team team `json:"team"`
+type ReviewRules interface {
+	SetTeamReviewRules(ctx context.Context, teamName string, rules []domain.ReviewRule) ([]domain.ReviewRule, error)
+	GetTeamReviewRules(ctx context.Context, teamName string) ([]domain.ReviewRule, error)
 }
 
 type Handler struct {
-	saver  Saver
-	getter Getter
+	saver       Saver
+	getter      Getter
+	capacity    Capacity
+	policy      WorkingHoursPolicy
+	mergePolicy MergePolicy
+	reviewRules ReviewRules
 }
 
-func NewHandler(saver Saver, getter Getter) *Handler {
+func NewHandler(saver Saver, getter Getter, capacity Capacity, policy WorkingHoursPolicy, mergePolicy MergePolicy, reviewRules ReviewRules) *Handler {
 	return &Handler{
-		saver:  saver,
-		getter: getter,
+		saver:       saver,
+		getter:      getter,
+		capacity:    capacity,
+		policy:      policy,
+		mergePolicy: mergePolicy,
+		reviewRules: reviewRules,
 	}
 }
 
-func (h *Handler) AddingTeam(w http.ResponseWriter, r *http.Request) {
-	defer r.Body.Close()
+func (h *Handler) AddTeam(ctx context.Context, request api.AddTeamRequestObject) (api.AddTeamResponseObject, error) {
+	err := h.saver.Save(ctx, toDomain(*request.Body))
+	if err != nil {
+		return nil, err
+	}
 
-	var t team
+	return api.AddTeam201JSONResponse{
+		Team: *request.Body,
+	}, nil
+}
 
-	err := render.DecodeJSON(r.Body, &t)
+func (h *Handler) GetTeam(ctx context.Context, request api.GetTeamRequestObject) (api.GetTeamResponseObject, error) {
+	teamDomain, err := h.getter.Get(ctx, request.Params.TeamName)
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
-		render.JSON(w, r, e.IncorrectDataError())
-		return
+		return nil, err
 	}
 
-	teamDomain := toDomain(t)
-	err = h.saver.Save(r.Context(), teamDomain)
+	return api.GetTeam200JSONResponse(domainTo(teamDomain)), nil
+}
+
+func (h *Handler) SetTeamMaxOpenReviews(ctx context.Context, request api.SetTeamMaxOpenReviewsRequestObject) (api.SetTeamMaxOpenReviewsResponseObject, error) {
+	capacity, err := h.capacity.SetTeamMaxOpenReviews(ctx, request.Body.TeamName, request.Body.MaxOpenReviews)
 	if err != nil {
-		if errors.Is(err, domain.ErrTeamExists) {
-			w.WriteHeader(http.StatusBadRequest)
-			render.JSON(w, r, e.TeamExistsError())
-			return
-		}
+		return nil, err
+	}
+
+	return api.SetTeamMaxOpenReviews200JSONResponse(capacityDomainTo(capacity)), nil
+}
 
-		w.WriteHeader(http.StatusInternalServerError)
-		render.JSON(w, r, e.InternalServerError())
-		return
+func (h *Handler) GetTeamCapacity(ctx context.Context, request api.GetTeamCapacityRequestObject) (api.GetTeamCapacityResponseObject, error) {
+	capacity, err := h.capacity.GetTeamCapacity(ctx, request.Params.TeamName)
+	if err != nil {
+		return nil, err
 	}
 
-	w.WriteHeader(http.StatusCreated)
-	render.JSON(w, r, responseAddTeam{
-		Team: t,
-	})
+	return api.GetTeamCapacity200JSONResponse(capacityDomainTo(capacity)), nil
 }
 
-func (h *Handler) GetTeam(w http.ResponseWriter, r *http.Request) {
-	teamName := r.URL.Query().Get("team_name")
-	if teamName == "" {
-		w.WriteHeader(http.StatusNotFound)
-		render.JSON(w, r, e.NotFoundError())
-		return
+func (h *Handler) SetTeamWorkingHoursPolicy(ctx context.Context, request api.SetTeamWorkingHoursPolicyRequestObject) (api.SetTeamWorkingHoursPolicyResponseObject, error) {
+	policy := domain.WorkingHoursPolicy(request.Body.WorkingHoursPolicy)
+	if err := h.policy.SetTeamWorkingHoursPolicy(ctx, request.Body.TeamName, policy); err != nil {
+		return nil, err
 	}
 
-	teamDomain, err := h.getter.Get(r.Context(), teamName)
+	return api.SetTeamWorkingHoursPolicy200JSONResponse(*request.Body), nil
+}
+
+func (h *Handler) SetTeamMergePolicy(ctx context.Context, request api.SetTeamMergePolicyRequestObject) (api.SetTeamMergePolicyResponseObject, error) {
+	policy := mergePolicyToDomain(request.Body.MergePolicy)
+	if err := h.mergePolicy.SetTeamMergePolicy(ctx, request.Body.TeamName, policy); err != nil {
+		return nil, err
+	}
+
+	return api.SetTeamMergePolicy200JSONResponse{
+		TeamName:    request.Body.TeamName,
+		MergePolicy: mergePolicyDomainTo(policy),
+	}, nil
+}
+
+func (h *Handler) GetTeamMergePolicy(ctx context.Context, request api.GetTeamMergePolicyRequestObject) (api.GetTeamMergePolicyResponseObject, error) {
+	policy, err := h.mergePolicy.GetTeamMergePolicy(ctx, request.Params.TeamName)
 	if err != nil {
-		if errors.Is(err, domain.ErrTeamNotFound) {
-			w.WriteHeader(http.StatusNotFound)
-			render.JSON(w, r, e.NotFoundError())
-			return
+		return nil, err
+	}
+
+	return api.GetTeamMergePolicy200JSONResponse{
+		TeamName:    request.Params.TeamName,
+		MergePolicy: mergePolicyDomainTo(policy),
+	}, nil
+}
+
+func (h *Handler) SetTeamReviewRules(ctx context.Context, request api.SetTeamReviewRulesRequestObject) (api.SetTeamReviewRulesResponseObject, error) {
+	rules := make([]domain.ReviewRule, len(request.Body.Rules))
+	for i, r := range request.Body.Rules {
+		rules[i] = reviewRuleToDomain(r)
+	}
+	rules, err := h.reviewRules.SetTeamReviewRules(ctx, request.Body.TeamName, rules)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.SetTeamReviewRules200JSONResponse(reviewRulesDomainTo(request.Body.TeamName, rules)), nil
+}
+
+func (h *Handler) GetTeamReviewRules(ctx context.Context, request api.GetTeamReviewRulesRequestObject) (api.GetTeamReviewRulesResponseObject, error) {
+	rules, err := h.reviewRules.GetTeamReviewRules(ctx, request.Params.TeamName)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.GetTeamReviewRules200JSONResponse(reviewRulesDomainTo(request.Params.TeamName, rules)), nil
+}
+
+func reviewRuleToDomain(r api.ReviewRule) domain.ReviewRule {
+	rule := domain.ReviewRule{RequiredSkills: r.RequiredSkills}
+	if r.Label != nil {
+		rule.Label = *r.Label
+	}
+	if r.Priority != nil {
+		rule.Priority = domain.Priority(*r.Priority)
+	}
+	if r.Size != nil {
+		rule.Size = domain.SizeBucket(*r.Size)
+	}
+	if r.SlaMinutes != nil {
+		rule.SLA = time.Duration(*r.SlaMinutes) * time.Minute
+	}
+	if r.ReviewersCount != nil {
+		rule.ReviewersCount = *r.ReviewersCount
+	}
+	return rule
+}
+
+func reviewRulesDomainTo(teamName string, rules []domain.ReviewRule) api.TeamReviewRules {
+	res := api.TeamReviewRules{
+		TeamName: teamName,
+		Rules:    make([]api.ReviewRule, len(rules)),
+	}
+	for i, r := range rules {
+		slaMinutes := int(r.SLA / time.Minute)
+		rule := api.ReviewRule{
+			RequiredSkills: r.RequiredSkills,
+			SlaMinutes:     &slaMinutes,
+			ReviewersCount: &r.ReviewersCount,
+		}
+		if r.Label != "" {
+			rule.Label = &r.Label
 		}
+		if r.Priority != "" {
+			priority := api.PullRequestPriority(r.Priority)
+			rule.Priority = &priority
+		}
+		if r.Size != "" {
+			size := api.PullRequestSize(r.Size)
+			rule.Size = &size
+		}
+		res.Rules[i] = rule
+	}
+	return res
+}
 
-		w.WriteHeader(http.StatusInternalServerError)
-		render.JSON(w, r, e.InternalServerError())
-		return
+func mergePolicyToDomain(p api.MergePolicy) domain.MergePolicy {
+	var policy domain.MergePolicy
+	if p.RequiredApprovals != nil {
+		policy.RequiredApprovals = *p.RequiredApprovals
+	}
+	if p.BlockOnChangesRequested != nil {
+		policy.BlockOnChangesRequested = *p.BlockOnChangesRequested
 	}
+	if p.RequireFullStaff != nil {
+		policy.RequireFullStaff = *p.RequireFullStaff
+	}
+	return policy
+}
 
-	t := domainTo(teamDomain)
+func mergePolicyDomainTo(p domain.MergePolicy) api.MergePolicy {
+	return api.MergePolicy{
+		RequiredApprovals:       &p.RequiredApprovals,
+		BlockOnChangesRequested: &p.BlockOnChangesRequested,
+		RequireFullStaff:        &p.RequireFullStaff,
+	}
+}
 
-	w.WriteHeader(http.StatusOK)
-	render.JSON(w, r, responseAddTeam{
-		Team: t,
-	})
+func capacityDomainTo(c *domain.TeamCapacity) api.TeamCapacity {
+	members := make([]api.ReviewerCapacity, len(c.Members))
+	for i, member := range c.Members {
+		members[i] = reviewerCapacityDomainTo(member)
+	}
+	return api.TeamCapacity{
+		TeamName:       c.TeamName,
+		MaxOpenReviews: c.MaxOpenReviews,
+		Members:        members,
+	}
 }
 
-func toDomain(team team) *domain.Team {
-	m := make([]*domain.User, len(team.Members))
+func reviewerCapacityDomainTo(c *domain.ReviewerCapacity) api.ReviewerCapacity {
+	return api.ReviewerCapacity{
+		UserId:         c.UserID,
+		Username:       c.Username,
+		IsActive:       c.IsActive,
+		OpenReviews:    c.OpenReviews,
+		MaxOpenReviews: c.MaxOpenReviews,
+		Remaining:      c.Remaining(),
+		CustomLimit:    c.CustomLimit,
+	}
+}
 
+func toDomain(team api.Team) *domain.Team {
+	m := make([]*domain.User, len(team.Members))
 	for i, member := range team.Members {
 		m[i] = &domain.User{
 			UserID:   member.UserId,
@@ -116,29 +252,26 @@ func toDomain(team team) *domain.Team {
 			IsActive: member.IsActive,
 		}
 	}
-
 	return &domain.Team{
 		TeamName: team.TeamName,
 		Members:  m,
 	}
 }
 
-func domainTo(t *domain.Team) team {
+func domainTo(t *domain.Team) api.Team {
 	if t == nil {
-		return team{}
+		return api.Team{}
 	}
-	members := make([]teamMember, len(t.Members))
+	members := make([]api.TeamMember, len(t.Members))
 	for i, member := range t.Members {
-		members[i] = teamMember{
+		members[i] = api.TeamMember{
 			UserId:   member.UserID,
 			Username: member.Username,
 			IsActive: member.IsActive,
 		}
 	}
-
-	return team{
+	return api.Team{
 		TeamName: t.TeamName,
 		Members:  members,
 	}
-
 }
@@ -1,40 +1,37 @@
 package team
 
 import (
+	"context"
 	"net/http"
 	"net/http/httptest"
-	"strings"
 	"testing"
 
+	"github.com/LeoUraltsev/PRReviewerService/api"
 	mocks_team "github.com/LeoUraltsev/PRReviewerService/internal/http/handler/team/mocks"
-	"github.com/LeoUraltsev/PRReviewerService/internal/http/middleware"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
-func TestHandler_AddingTeam(t *testing.T) {
+func TestHandler_AddTeam(t *testing.T) {
 	m := mocks_team.NewMockSaver(t)
 	m.EXPECT().Save(mock.Anything, mock.Anything).Return(nil).Once()
 
-	r := httptest.NewRequest("POST", "/team/add", strings.NewReader(`{
-  "team_name": "payments",
-  "members": [
-    {
-      "user_id": "u1",
-      "username": "Alice",
-      "is_active": true
-    },
-    {
-      "user_id": "u2",
-      "username": "Bob",
-      "is_active": true
-    }
-  ]
-}`))
-	w := httptest.NewRecorder()
-	h := NewHandler(m, nil)
+	h := NewHandler(m, nil, nil, nil, nil, nil)
+
+	resp, err := h.AddTeam(context.Background(), api.AddTeamRequestObject{
+		Body: &api.Team{
+			TeamName: "payments",
+			Members: []api.TeamMember{
+				{UserId: "u1", Username: "Alice", IsActive: true},
+				{UserId: "u2", Username: "Bob", IsActive: true},
+			},
+		},
+	})
+	require.NoError(t, err)
 
-	middleware.ContentTypeApplicationJson(http.HandlerFunc(h.AddingTeam)).ServeHTTP(w, r)
+	w := httptest.NewRecorder()
+	require.NoError(t, resp.VisitAddTeamResponse(w))
 
 	assert.Equal(t, http.StatusCreated, w.Code)
 	assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
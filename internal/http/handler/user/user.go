@@ -2,141 +2,172 @@ package user
 
 import (
 	"context"
-	"errors"
-	"net/http"
 
+	"github.com/LeoUraltsev/PRReviewerService/api"
 	"github.com/LeoUraltsev/PRReviewerService/internal/domain"
-	e "github.com/LeoUraltsev/PRReviewerService/internal/http/handler/helper/err"
-	"github.com/go-chi/render"
+	"github.com/LeoUraltsev/PRReviewerService/internal/http/handler/helper/etag"
 )
 
 type Updater interface {
-	UpdateIsActive(ctx context.Context, userId string, isActive bool) (*domain.User, error)
+	UpdateIsActive(ctx context.Context, userId string, version int64, isActive bool) (*domain.User, error)
 }
 
 type Getter interface {
-	GetUserPullRequest(ctx context.Context, userId string) ([]*domain.PullRequest, error)
+	GetUserPullRequest(ctx context.Context, userId string, filter domain.PullRequestFilter) ([]*domain.PullRequest, error)
 }
 
-type isActiveRequest struct {
-	UserId   string `json:"user_id"`
-	IsActive bool   `json:"is_active"`
+type Capacity interface {
+	SetUserMaxOpenReviews(ctx context.Context, userID string, version int64, limit *int) (*domain.ReviewerCapacity, error)
 }
 
-type isActiveResponse struct {
-	user `json:"user"`
-}
-
-type getReviewResponse struct {
-	UserId       string              `json:"user_id"`
-	PullRequests []smallPullRequests `json:"pull_requests"`
-}
-
-type smallPullRequests struct {
-	PullRequestId   string `json:"pull_request_id"`
-	PullRequestName string `json:"pull_request_name"`
-	AuthorId        string `json:"author_id"`
-	Status          string `json:"status"`
-}
-
-type user struct {
-	UserId   string `json:"user_id"`
-	Username string `json:"username"`
-	TeamName string `json:"team_name"`
-	IsActive bool   `json:"is_active"`
+type Skills interface {
+	SetUserSkills(ctx context.Context, userID string, version int64, skills []string) (*domain.UserSkills, error)
+	GetUserSkills(ctx context.Context, userID string) (*domain.UserSkills, error)
 }
 
 type Handler struct {
-	updater Updater
-	getter  Getter
+	updater      Updater
+	getter       Getter
+	skills       Skills
+	capacity     Capacity
+	workingHours WorkingHours
 }
 
-func NewHandler(updater Updater, getter Getter) *Handler {
+func NewHandler(updater Updater, getter Getter, skills Skills, capacity Capacity, workingHours WorkingHours) *Handler {
 	return &Handler{
-		updater: updater,
-		getter:  getter,
+		updater:      updater,
+		getter:       getter,
+		skills:       skills,
+		capacity:     capacity,
+		workingHours: workingHours,
 	}
 }
 
-func (h *Handler) SetIsActive(w http.ResponseWriter, r *http.Request) {
-	defer r.Body.Close()
-
-	var req isActiveRequest
-	err := render.DecodeJSON(r.Body, &req)
+func (h *Handler) SetUserIsActive(ctx context.Context, request api.SetUserIsActiveRequestObject) (api.SetUserIsActiveResponseObject, error) {
+	version, err := etag.ParseIfMatch(request.Params.IfMatch)
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
-		render.JSON(w, r, e.IncorrectDataError())
-		return
+		return nil, err
 	}
-
-	u, err := h.updater.UpdateIsActive(r.Context(), req.UserId, req.IsActive)
+	u, err := h.updater.UpdateIsActive(ctx, request.Body.UserId, version, request.Body.IsActive)
 	if err != nil {
-		if errors.Is(err, domain.ErrUserNotFound) || errors.Is(err, domain.ErrIncorrectAdminToken) {
-			w.WriteHeader(http.StatusNotFound)
-			render.JSON(w, r, e.NotFoundError())
-		}
+		return nil, err
+	}
+
+	return api.SetUserIsActive200JSONResponse{
+		Body:    api.UserResponse{User: userDomainTo(u)},
+		Headers: api.SetUserIsActive200ResponseHeaders{ETag: etag.Format(u.Version)},
+	}, nil
+}
 
-		w.WriteHeader(http.StatusInternalServerError)
-		render.JSON(w, r, e.InternalServerError())
-		return
+func (h *Handler) GetUserReviews(ctx context.Context, request api.GetUserReviewsRequestObject) (api.GetUserReviewsResponseObject, error) {
+	userID := request.Params.UserId
+	var filter domain.PullRequestFilter
+	if request.Params.Label != nil {
+		filter.Label = *request.Params.Label
+	}
+	if request.Params.Priority != nil {
+		filter.Priority = domain.Priority(*request.Params.Priority)
+	}
+	prDomain, err := h.getter.GetUserPullRequest(ctx, userID, filter)
+	if err != nil {
+		return nil, err
 	}
 
-	resp := isActiveResponse{
-		user: userDomainTo(u),
+	pr := make([]api.PullRequestShort, len(prDomain))
+	for i, v := range prDomain {
+		pr[i] = prDomainToSmallPullRequests(v, userID)
 	}
 
-	w.WriteHeader(http.StatusOK)
-	render.JSON(w, r, resp)
+	return api.GetUserReviews200JSONResponse{
+		UserId:       userID,
+		PullRequests: pr,
+	}, nil
 }
 
-func (h *Handler) GetReview(w http.ResponseWriter, r *http.Request) {
-	userID := r.URL.Query().Get("user_id")
-	if userID == "" {
-		w.WriteHeader(http.StatusBadRequest)
-		render.JSON(w, r, e.IncorrectDataError())
-		return
+func (h *Handler) SetUserSkills(ctx context.Context, request api.SetUserSkillsRequestObject) (api.SetUserSkillsResponseObject, error) {
+	version, err := etag.ParseIfMatch(request.Params.IfMatch)
+	if err != nil {
+		return nil, err
+	}
+	skills, err := h.skills.SetUserSkills(ctx, request.Body.UserId, version, request.Body.Skills)
+	if err != nil {
+		return nil, err
 	}
 
-	prDomain, err := h.getter.GetUserPullRequest(r.Context(), userID)
+	return api.SetUserSkills200JSONResponse{
+		Body:    skillsDomainTo(skills),
+		Headers: api.SetUserSkills200ResponseHeaders{ETag: etag.Format(skills.Version)},
+	}, nil
+}
+
+func (h *Handler) GetUserSkills(ctx context.Context, request api.GetUserSkillsRequestObject) (api.GetUserSkillsResponseObject, error) {
+	skills, err := h.skills.GetUserSkills(ctx, request.Params.UserId)
 	if err != nil {
-		if errors.Is(err, domain.ErrUserNotFound) {
-			w.WriteHeader(http.StatusNotFound)
-			render.JSON(w, r, e.NotFoundError())
-			return
-		}
-		w.WriteHeader(http.StatusInternalServerError)
-		render.JSON(w, r, e.InternalServerError())
-		return
+		return nil, err
 	}
 
-	pr := make([]smallPullRequests, len(prDomain))
+	return api.GetUserSkills200JSONResponse(skillsDomainTo(skills)), nil
+}
 
-	for i, v := range prDomain {
-		pr[i] = prDomainToSmallPullRequests(v)
+func (h *Handler) SetUserMaxOpenReviews(ctx context.Context, request api.SetUserMaxOpenReviewsRequestObject) (api.SetUserMaxOpenReviewsResponseObject, error) {
+	version, err := etag.ParseIfMatch(request.Params.IfMatch)
+	if err != nil {
+		return nil, err
+	}
+	c, err := h.capacity.SetUserMaxOpenReviews(ctx, request.Body.UserId, version, request.Body.MaxOpenReviews)
+	if err != nil {
+		return nil, err
 	}
 
-	w.WriteHeader(http.StatusOK)
-	render.JSON(w, r, getReviewResponse{
-		UserId:       userID,
-		PullRequests: pr,
-	})
+	return api.SetUserMaxOpenReviews200JSONResponse{
+		Body: api.ReviewerCapacity{
+			UserId:         c.UserID,
+			Username:       c.Username,
+			IsActive:       c.IsActive,
+			OpenReviews:    c.OpenReviews,
+			MaxOpenReviews: c.MaxOpenReviews,
+			Remaining:      c.Remaining(),
+			CustomLimit:    c.CustomLimit,
+		},
+		Headers: api.SetUserMaxOpenReviews200ResponseHeaders{ETag: etag.Format(c.Version)},
+	}, nil
+}
 
+func skillsDomainTo(s *domain.UserSkills) api.UserSkills {
+	return api.UserSkills{
+		UserId: s.UserID,
+		Skills: s.Skills,
+	}
 }
 
-func userDomainTo(u *domain.User) user {
-	return user{
+func userDomainTo(u *domain.User) api.User {
+	return api.User{
 		UserId:   u.UserID,
 		Username: u.Username,
 		TeamName: u.TeamName,
 		IsActive: u.IsActive,
+		Version:  &u.Version,
 	}
 }
 
-func prDomainToSmallPullRequests(pr *domain.PullRequest) smallPullRequests {
-	return smallPullRequests{
+// prDomainToSmallPullRequests переводит PR в краткую форму с состоянием ревью пользователя reviewerID.
+func prDomainToSmallPullRequests(pr *domain.PullRequest, reviewerID string) api.PullRequestShort {
+	res := api.PullRequestShort{
 		PullRequestId:   pr.ID,
 		PullRequestName: pr.Name,
 		AuthorId:        pr.AuthorID,
-		Status:          pr.Status.String(),
+		Status:          api.PullRequestStatus(pr.Status),
+		Labels:          pr.Labels,
+		Priority:        api.PullRequestPriority(pr.Priority),
+		ReviewDueAt:     pr.ReviewDueAt,
+	}
+	for _, r := range pr.Reviews {
+		if r.ReviewerID == reviewerID {
+			state := api.ReviewState(r.Verdict)
+			res.ReviewState = &state
+			res.SubmittedAt = r.SubmittedAt
+			break
+		}
 	}
+	return res
 }
@@ -0,0 +1,42 @@
+package user
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/LeoUraltsev/PRReviewerService/api"
+	"github.com/LeoUraltsev/PRReviewerService/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubGetter []*domain.PullRequest
+
+func (s stubGetter) GetUserPullRequest(context.Context, string, domain.PullRequestFilter) ([]*domain.PullRequest, error) {
+	return s, nil
+}
+
+func TestHandler_GetUserReviews_ShowsVerdict(t *testing.T) {
+	submittedAt := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	h := NewHandler(nil, stubGetter{{
+		ID:       "pr-1",
+		AuthorID: "u1",
+		Status:   domain.Open,
+		Reviews: []domain.Review{
+			{ReviewerID: "u3", Verdict: domain.VerdictPending},
+			{ReviewerID: "u2", Verdict: domain.VerdictChangesRequested, SubmittedAt: &submittedAt},
+		},
+	}}, nil, nil, nil)
+
+	resp, err := h.GetUserReviews(context.Background(), api.GetUserReviewsRequestObject{
+		Params: api.GetUserReviewsParams{UserId: "u2"},
+	})
+	require.NoError(t, err)
+
+	reviews := resp.(api.GetUserReviews200JSONResponse)
+	require.Len(t, reviews.PullRequests, 1)
+	require.NotNil(t, reviews.PullRequests[0].ReviewState)
+	assert.Equal(t, api.ReviewState(domain.VerdictChangesRequested), *reviews.PullRequests[0].ReviewState)
+	assert.Equal(t, &submittedAt, reviews.PullRequests[0].SubmittedAt)
+}
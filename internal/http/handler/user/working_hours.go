@@ -0,0 +1,102 @@
+package user
+
+import (
+	"context"
+	"time"
+
+	"github.com/LeoUraltsev/PRReviewerService/api"
+	"github.com/LeoUraltsev/PRReviewerService/internal/domain"
+)
+
+type WorkingHours interface {
+	SetUserWorkingHours(ctx context.Context, hours *domain.WorkingHours) (*domain.WorkingHours, error)
+	GetUserWorkingHours(ctx context.Context, userID string) (*domain.WorkingHours, error)
+	DeleteUserWorkingHours(ctx context.Context, userID string) error
+}
+
+var weekdays = map[api.Weekday]time.Weekday{
+	api.Monday:    time.Monday,
+	api.Tuesday:   time.Tuesday,
+	api.Wednesday: time.Wednesday,
+	api.Thursday:  time.Thursday,
+	api.Friday:    time.Friday,
+	api.Saturday:  time.Saturday,
+	api.Sunday:    time.Sunday,
+}
+
+func (h *Handler) SetUserWorkingHours(ctx context.Context, request api.SetUserWorkingHoursRequestObject) (api.SetUserWorkingHoursResponseObject, error) {
+	hours, err := workingHoursToDomain(request.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	hours, err = h.workingHours.SetUserWorkingHours(ctx, hours)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.SetUserWorkingHours200JSONResponse(workingHoursDomainTo(hours)), nil
+}
+
+func (h *Handler) GetUserWorkingHours(ctx context.Context, request api.GetUserWorkingHoursRequestObject) (api.GetUserWorkingHoursResponseObject, error) {
+	hours, err := h.workingHours.GetUserWorkingHours(ctx, request.Params.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.GetUserWorkingHours200JSONResponse(workingHoursDomainTo(hours)), nil
+}
+
+func (h *Handler) DeleteUserWorkingHours(ctx context.Context, request api.DeleteUserWorkingHoursRequestObject) (api.DeleteUserWorkingHoursResponseObject, error) {
+	if err := h.workingHours.DeleteUserWorkingHours(ctx, request.Body.UserId); err != nil {
+		return nil, err
+	}
+	return api.DeleteUserWorkingHours204Response{}, nil
+}
+
+func workingHoursToDomain(body *api.WorkingHoursRequest) (*domain.WorkingHours, error) {
+	start, err := domain.ParseClockTime(body.Start)
+	if err != nil {
+		return nil, err
+	}
+	end, err := domain.ParseClockTime(body.End)
+	if err != nil {
+		return nil, err
+	}
+
+	hours := &domain.WorkingHours{
+		UserID:   body.UserId,
+		TimeZone: body.TimeZone,
+		Start:    start,
+		End:      end,
+	}
+	for _, d := range body.Days {
+		day, ok := weekdays[d]
+		if !ok {
+			return nil, domain.NewError(domain.CodeIncorrectData, "unknown weekday "+string(d))
+		}
+		hours.Days = append(hours.Days, day)
+	}
+	return hours, nil
+}
+
+func workingHoursDomainTo(hours *domain.WorkingHours) api.WorkingHours {
+	days := make([]api.Weekday, 0, len(hours.Days))
+	for _, d := range []api.Weekday{api.Monday, api.Tuesday, api.Wednesday, api.Thursday, api.Friday, api.Saturday, api.Sunday} {
+		for _, day := range hours.Days {
+			if weekdays[d] == day {
+				days = append(days, d)
+				break
+			}
+		}
+	}
+
+	return api.WorkingHours{
+		UserId:             hours.UserID,
+		TimeZone:           hours.TimeZone,
+		Days:               days,
+		Start:              domain.FormatClockTime(hours.Start),
+		End:                domain.FormatClockTime(hours.End),
+		WorkingHoursPolicy: api.WorkingHoursPolicy(hours.Policy),
+	}
+}
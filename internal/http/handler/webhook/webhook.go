@@ -0,0 +1,107 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/LeoUraltsev/PRReviewerService/api"
+	"github.com/LeoUraltsev/PRReviewerService/internal/domain"
+)
+
+type Subscriber interface {
+	Subscribe(ctx context.Context, url string, events []domain.EventType, secret string) (*domain.WebhookSubscription, error)
+	Unsubscribe(ctx context.Context, id string) error
+}
+
+type Getter interface {
+	GetDeliveries(ctx context.Context, subscriptionID string, limit int) ([]*domain.WebhookDelivery, error)
+}
+
+type Handler struct {
+	subscriber Subscriber
+	getter     Getter
+}
+
+func NewHandler(subscriber Subscriber, getter Getter) *Handler {
+	return &Handler{
+		subscriber: subscriber,
+		getter:     getter,
+	}
+}
+
+func (h *Handler) SubscribeWebhook(ctx context.Context, request api.SubscribeWebhookRequestObject) (api.SubscribeWebhookResponseObject, error) {
+	events := make([]domain.EventType, len(request.Body.Events))
+	for i, e := range request.Body.Events {
+		events[i] = domain.EventType(e)
+	}
+	secret := ""
+	if request.Body.Secret != nil {
+		secret = *request.Body.Secret
+	}
+
+	sub, err := h.subscriber.Subscribe(ctx, request.Body.Url, events, secret)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := subscriptionDomainTo(sub)
+	resp.Secret = &sub.Secret
+	return api.SubscribeWebhook201JSONResponse{
+		Subscription: resp,
+	}, nil
+}
+
+func (h *Handler) UnsubscribeWebhook(ctx context.Context, request api.UnsubscribeWebhookRequestObject) (api.UnsubscribeWebhookResponseObject, error) {
+	err := h.subscriber.Unsubscribe(ctx, request.Body.SubscriptionId)
+	if err != nil {
+		return nil, err
+	}
+	return api.UnsubscribeWebhook204Response{}, nil
+}
+
+func (h *Handler) GetWebhookDeliveries(ctx context.Context, request api.GetWebhookDeliveriesRequestObject) (api.GetWebhookDeliveriesResponseObject, error) {
+	limit := 0
+	if request.Params.Limit != nil {
+		limit = *request.Params.Limit
+	}
+
+	deliveries, err := h.getter.GetDeliveries(ctx, request.Params.SubscriptionId, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make([]api.WebhookDelivery, len(deliveries))
+	for i, d := range deliveries {
+		resp[i] = deliveryDomainTo(d)
+	}
+	return api.GetWebhookDeliveries200JSONResponse{
+		SubscriptionId: request.Params.SubscriptionId,
+		Deliveries:     resp,
+	}, nil
+}
+
+func subscriptionDomainTo(sub *domain.WebhookSubscription) api.WebhookSubscription {
+	events := make([]api.WebhookEventType, len(sub.Events))
+	for i, e := range sub.Events {
+		events[i] = api.WebhookEventType(e)
+	}
+	return api.WebhookSubscription{
+		SubscriptionId: sub.ID,
+		Url:            sub.URL,
+		Events:         events,
+		CreatedAt:      sub.CreatedAt,
+	}
+}
+
+func deliveryDomainTo(d *domain.WebhookDelivery) api.WebhookDelivery {
+	return api.WebhookDelivery{
+		DeliveryId:     d.ID,
+		SubscriptionId: d.SubscriptionID,
+		EventId:        d.EventID,
+		EventType:      api.WebhookEventType(d.EventType),
+		Attempt:        d.Attempt,
+		StatusCode:     d.StatusCode,
+		Error:          d.Error,
+		Success:        d.Success,
+		CreatedAt:      d.CreatedAt,
+	}
+}
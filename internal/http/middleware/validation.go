@@ -0,0 +1,164 @@
+package middleware
+
+import (
+	"bytes"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/LeoUraltsev/PRReviewerService/api"
+	e "github.com/LeoUraltsev/PRReviewerService/internal/http/handler/helper/err"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/gorillamux"
+	"github.com/go-chi/render"
+)
+
+// OpenAPIValidator проверяет запросы и ответы на соответствие OpenAPI-спецификации.
+// В строгом режиме ответ, не прошедший проверку, заменяется на 500 с описанием расхождений;
+// в обычном режиме расхождение только логируется, а клиент получает исходный ответ.
+type OpenAPIValidator struct {
+	log    *slog.Logger
+	router routers.Router
+	strict bool
+}
+
+func NewOpenAPIValidator(log *slog.Logger, spec []byte, strict bool) (*OpenAPIValidator, error) {
+	loader := openapi3.NewLoader()
+	doc, err := loader.LoadFromData(spec)
+	if err != nil {
+		return nil, fmt.Errorf("failed load openapi spec: %w", err)
+	}
+	if err = doc.Validate(loader.Context); err != nil {
+		return nil, fmt.Errorf("invalid openapi spec: %w", err)
+	}
+
+	// Маршрутизация не должна зависеть от хоста, указанного в servers.
+	doc.Servers = nil
+	router, err := gorillamux.NewRouter(doc)
+	if err != nil {
+		return nil, fmt.Errorf("failed create openapi router: %w", err)
+	}
+
+	return &OpenAPIValidator{
+		log:    log,
+		router: router,
+		strict: strict,
+	}, nil
+}
+
+func (v *OpenAPIValidator) Validate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		route, pathParams, err := v.router.FindRoute(r)
+		if err != nil {
+			// Маршруты, которых нет в спецификации, пропускаем без проверки.
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		reqInput := &openapi3filter.RequestValidationInput{
+			Request:    r,
+			PathParams: pathParams,
+			Route:      route,
+			Options: &openapi3filter.Options{
+				MultiError:         true,
+				AuthenticationFunc: openapi3filter.NoopAuthenticationFunc,
+			},
+		}
+		if err = openapi3filter.ValidateRequest(r.Context(), reqInput); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, e.ValidationError(fieldErrors(err, "")))
+			return
+		}
+
+		rec := &responseRecorder{ResponseWriter: w}
+		next.ServeHTTP(rec, r)
+
+		respInput := &openapi3filter.ResponseValidationInput{
+			RequestValidationInput: reqInput,
+			Status:                 rec.Status(),
+			Header:                 w.Header(),
+			Options:                &openapi3filter.Options{MultiError: true},
+		}
+		respInput.SetBodyBytes(rec.body.Bytes())
+		if err = openapi3filter.ValidateResponse(r.Context(), respInput); err != nil {
+			if v.strict {
+				resp := e.NewErrorResponse(api.ErrorCodeInternalServerError, "response validation failed")
+				resp.Error.Details = fieldErrors(err, "")
+				w.WriteHeader(http.StatusInternalServerError)
+				render.JSON(w, r, resp)
+				return
+			}
+			v.log.Warn("response does not match openapi spec",
+				"method", r.Method,
+				"path", r.URL.Path,
+				"status", rec.Status(),
+				"err", err,
+			)
+		}
+
+		w.WriteHeader(rec.Status())
+		_, _ = w.Write(rec.body.Bytes())
+	})
+}
+
+// fieldErrors разворачивает ошибки kin-openapi в плоский список ошибок по полям.
+func fieldErrors(err error, field string) []api.FieldError {
+	switch v := err.(type) {
+	case openapi3.MultiError:
+		var details []api.FieldError
+		for _, item := range v {
+			details = append(details, fieldErrors(item, field)...)
+		}
+		return details
+	case *openapi3filter.RequestError:
+		if v.Parameter != nil {
+			field = v.Parameter.Name
+		}
+		if v.Err != nil {
+			return fieldErrors(v.Err, field)
+		}
+		return []api.FieldError{{Field: field, Message: v.Reason}}
+	case *openapi3filter.ResponseError:
+		if v.Err != nil {
+			return fieldErrors(v.Err, field)
+		}
+		return []api.FieldError{{Field: field, Message: v.Reason}}
+	case *openapi3.SchemaError:
+		if pointer := v.JSONPointer(); len(pointer) > 0 {
+			field = strings.Join(pointer, "/")
+		}
+		return []api.FieldError{{Field: field, Message: v.Reason}}
+	}
+	return []api.FieldError{{Field: field, Message: err.Error()}}
+}
+
+// responseRecorder буферизует ответ обработчика, чтобы его можно было проверить до отправки клиенту.
+// Заголовки пишутся напрямую в исходный ResponseWriter.
+type responseRecorder struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (r *responseRecorder) WriteHeader(status int) {
+	if r.status == 0 {
+		r.status = status
+	}
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	if r.status == 0 {
+		r.status = http.StatusOK
+	}
+	return r.body.Write(b)
+}
+
+func (r *responseRecorder) Status() int {
+	if r.status == 0 {
+		return http.StatusOK
+	}
+	return r.status
+}
@@ -0,0 +1,92 @@
+package middleware
+
+import (
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/LeoUraltsev/PRReviewerService/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const validPR = `{"pr":{"pull_request_id":"pr-1","pull_request_name":"Add search","author_id":"u1","priority":"NORMAL","status":"OPEN","assigned_reviewers":["u2"]},"assignments":[{"user_id":"u2","source":"team"}]}`
+
+func TestOpenAPIValidator_Validate(t *testing.T) {
+	tests := []struct {
+		name        string
+		strict      bool
+		body        string
+		handlerBody string
+		wantCode    int
+		wantDetails []api.FieldError
+	}{
+		{
+			name:        "valid request and response",
+			strict:      true,
+			body:        `{"pull_request_id":"pr-1","pull_request_name":"Add search","author_id":"u1"}`,
+			handlerBody: validPR,
+			wantCode:    http.StatusCreated,
+		},
+		{
+			name:     "empty pull_request_id",
+			strict:   true,
+			body:     `{"pull_request_id":"","pull_request_name":"Add search","author_id":"u1"}`,
+			wantCode: http.StatusBadRequest,
+			wantDetails: []api.FieldError{
+				{Field: "pull_request_id", Message: "minimum string length is 1"},
+			},
+		},
+		{
+			name:     "missing author_id",
+			strict:   true,
+			body:     `{"pull_request_id":"pr-1","pull_request_name":"Add search"}`,
+			wantCode: http.StatusBadRequest,
+			wantDetails: []api.FieldError{
+				{Field: "author_id", Message: `property "author_id" is missing`},
+			},
+		},
+		{
+			name:        "invalid response in strict mode",
+			strict:      true,
+			body:        `{"pull_request_id":"pr-1","pull_request_name":"Add search","author_id":"u1"}`,
+			handlerBody: `{"pr":{"pull_request_id":"pr-1","status":"UNKNOWN"}}`,
+			wantCode:    http.StatusInternalServerError,
+		},
+		{
+			name:        "invalid response in lenient mode",
+			strict:      false,
+			body:        `{"pull_request_id":"pr-1","pull_request_name":"Add search","author_id":"u1"}`,
+			handlerBody: `{"pr":{"pull_request_id":"pr-1","status":"UNKNOWN"}}`,
+			wantCode:    http.StatusCreated,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := NewOpenAPIValidator(slog.New(slog.NewTextHandler(io.Discard, nil)), api.Spec, tt.strict)
+			require.NoError(t, err)
+
+			handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusCreated)
+				_, _ = w.Write([]byte(tt.handlerBody))
+			})
+
+			r := httptest.NewRequest(http.MethodPost, "/pullRequest/create", strings.NewReader(tt.body))
+			r.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			ContentTypeApplicationJson(v.Validate(handler)).ServeHTTP(w, r)
+
+			assert.Equal(t, tt.wantCode, w.Code)
+			if tt.wantDetails != nil {
+				var resp api.ErrorResponse
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+				assert.Equal(t, api.ErrorCodeIncorrectData, resp.Error.Code)
+				assert.Equal(t, tt.wantDetails, resp.Error.Details)
+			}
+		})
+	}
+}
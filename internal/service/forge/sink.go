@@ -0,0 +1,152 @@
+package forge
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/LeoUraltsev/PRReviewerService/internal/domain"
+	"go.opentelemetry.io/otel"
+)
+
+var tracer = otel.Tracer("github.com/LeoUraltsev/PRReviewerService/internal/service/forge")
+
+// ForgeClient управляет ревьюверами и комментариями PR во внешнем форже.
+type ForgeClient interface {
+	RequestReviewers(ctx context.Context, pr *domain.ForgePullRequest, logins []string) error
+	RemoveReviewer(ctx context.Context, pr *domain.ForgePullRequest, login string) error
+	PostComment(ctx context.Context, pr *domain.ForgePullRequest, body string) error
+}
+
+// NoopClient ничего не делает; используется для форжей без настроенного клиента.
+type NoopClient struct{}
+
+func (NoopClient) RequestReviewers(context.Context, *domain.ForgePullRequest, []string) error {
+	return nil
+}
+
+func (NoopClient) RemoveReviewer(context.Context, *domain.ForgePullRequest, string) error {
+	return nil
+}
+
+func (NoopClient) PostComment(context.Context, *domain.ForgePullRequest, string) error {
+	return nil
+}
+
+type RepoForge interface {
+	GetPullRequestRefByID(ctx context.Context, prID string) (*domain.ForgePullRequest, error)
+	GetLoginByUserID(ctx context.Context, provider domain.ForgeProvider, userID string) (string, error)
+}
+
+// Sink — получатель событий outbox, который переносит назначения ревьюверов в форж,
+// из которого импортирован PR. Ошибка клиента возвращается relay, и тот повторяет событие
+// с экспоненциальной паузой, так что сбой форжа не влияет на создание PR. Relay учитывает доставку
+// в каждый sink отдельно, поэтому сбой других получателей не приводит к повторным комментариям
+// и запросам ревью в форже. Комментарий отправляется последним, чтобы повтор после собственного
+// сбоя sink не дублировал его.
+type Sink struct {
+	log     *slog.Logger
+	repo    RepoForge
+	clients map[domain.ForgeProvider]ForgeClient
+}
+
+func NewSink(log *slog.Logger, repo RepoForge, clients map[domain.ForgeProvider]ForgeClient) *Sink {
+	return &Sink{
+		log:     log,
+		repo:    repo,
+		clients: clients,
+	}
+}
+
+func (s *Sink) Name() string {
+	return "forge"
+}
+
+func (s *Sink) Deliver(ctx context.Context, event domain.Event) error {
+	if event.PullRequest == nil {
+		return nil
+	}
+	switch event.Type {
+	case domain.EventReviewerAssigned, domain.EventReviewerReassigned, domain.EventReviewerRemoved, domain.EventPRUnderstaffed:
+	default:
+		return nil
+	}
+
+	ctx, span := tracer.Start(ctx, "ForgeSink.Deliver")
+	defer span.End()
+
+	ref, err := s.repo.GetPullRequestRefByID(ctx, event.PullRequest.ID)
+	if err != nil {
+		if errors.Is(err, domain.ErrForgePRNotFound) {
+			// PR создан через API и в форже не существует.
+			return nil
+		}
+		return err
+	}
+	client, ok := s.clients[ref.Provider]
+	if !ok {
+		client = NoopClient{}
+	}
+
+	switch event.Type {
+	case domain.EventReviewerAssigned:
+		login, err := s.login(ctx, ref.Provider, event.ReviewerID)
+		if err != nil || login == "" {
+			return err
+		}
+		return client.RequestReviewers(ctx, ref, []string{login})
+
+	case domain.EventReviewerRemoved:
+		login, err := s.login(ctx, ref.Provider, event.ReviewerID)
+		if err != nil || login == "" {
+			return err
+		}
+		return client.RemoveReviewer(ctx, ref, login)
+
+	case domain.EventReviewerReassigned:
+		oldLogin, err := s.login(ctx, ref.Provider, event.OldReviewerID)
+		if err != nil {
+			return err
+		}
+		newLogin, err := s.login(ctx, ref.Provider, event.ReviewerID)
+		if err != nil {
+			return err
+		}
+		if oldLogin != "" {
+			if err = client.RemoveReviewer(ctx, ref, oldLogin); err != nil {
+				return err
+			}
+		}
+		if newLogin != "" {
+			if err = client.RequestReviewers(ctx, ref, []string{newLogin}); err != nil {
+				return err
+			}
+		}
+		return client.PostComment(ctx, ref, fmt.Sprintf("Reviewer %s was replaced by %s.",
+			mention(oldLogin, event.OldReviewerID), mention(newLogin, event.ReviewerID)))
+
+	default:
+		return client.PostComment(ctx, ref, "Not enough active reviewers in the team, please assign the rest manually.")
+	}
+}
+
+// login возвращает логин пользователя в форже или пустую строку, если связь не настроена.
+func (s *Sink) login(ctx context.Context, provider domain.ForgeProvider, userID string) (string, error) {
+	login, err := s.repo.GetLoginByUserID(ctx, provider, userID)
+	if err != nil {
+		if errors.Is(err, domain.ErrForgeAccountNotFound) {
+			s.log.Warn("reviewer has no linked forge account", "provider", provider, "user", userID)
+			return "", nil
+		}
+		return "", err
+	}
+	return login, nil
+}
+
+func mention(login string, userID string) string {
+	if login == "" {
+		return userID
+	}
+	return "@" + login
+}
@@ -0,0 +1,168 @@
+package forge
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/LeoUraltsev/PRReviewerService/internal/client/github"
+	"github.com/LeoUraltsev/PRReviewerService/internal/client/github/githubtest"
+	"github.com/LeoUraltsev/PRReviewerService/internal/domain"
+	"github.com/LeoUraltsev/PRReviewerService/internal/service/outbox"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type memoryForge struct {
+	refs   map[string]*domain.ForgePullRequest
+	logins map[string]string
+}
+
+func (m *memoryForge) GetPullRequestRefByID(_ context.Context, prID string) (*domain.ForgePullRequest, error) {
+	ref, ok := m.refs[prID]
+	if !ok {
+		return nil, domain.ErrForgePRNotFound
+	}
+	return ref, nil
+}
+
+func (m *memoryForge) GetLoginByUserID(_ context.Context, _ domain.ForgeProvider, userID string) (string, error) {
+	login, ok := m.logins[userID]
+	if !ok {
+		return "", domain.ErrForgeAccountNotFound
+	}
+	return login, nil
+}
+
+func TestSink_Deliver(t *testing.T) {
+	server := githubtest.NewServer()
+	defer server.Close()
+
+	repo := &memoryForge{
+		refs: map[string]*domain.ForgePullRequest{
+			"github:acme/pr-service#42": {Provider: domain.ForgeGitHub, Repository: "acme/pr-service", Number: 42},
+		},
+		logins: map[string]string{"u2": "bob-gh", "u3": "carol-gh"},
+	}
+	sink := NewSink(slog.New(slog.NewTextHandler(io.Discard, nil)), repo, map[domain.ForgeProvider]ForgeClient{
+		domain.ForgeGitHub: github.NewClient(server.URL, "token", server.Client()),
+	})
+
+	assigned := domain.NewEvent(domain.EventReviewerAssigned)
+	assigned.PullRequest = &domain.PullRequest{ID: "github:acme/pr-service#42"}
+	assigned.ReviewerID = "u2"
+
+	// Ошибка форжа возвращается relay, чтобы событие было отправлено повторно.
+	server.FailNext(1)
+	require.Error(t, sink.Deliver(context.Background(), assigned))
+	require.NoError(t, sink.Deliver(context.Background(), assigned))
+
+	reassigned := domain.NewEvent(domain.EventReviewerReassigned)
+	reassigned.PullRequest = assigned.PullRequest
+	reassigned.OldReviewerID = "u2"
+	reassigned.ReviewerID = "u3"
+	require.NoError(t, sink.Deliver(context.Background(), reassigned))
+
+	removed := domain.NewEvent(domain.EventReviewerRemoved)
+	removed.PullRequest = assigned.PullRequest
+	removed.ReviewerID = "u3"
+	require.NoError(t, sink.Deliver(context.Background(), removed))
+
+	// PR, созданные через API, в форж не попадают.
+	manual := domain.NewEvent(domain.EventReviewerAssigned)
+	manual.PullRequest = &domain.PullRequest{ID: "pr-1"}
+	manual.ReviewerID = "u2"
+	require.NoError(t, sink.Deliver(context.Background(), manual))
+
+	calls := server.Calls()
+	require.Len(t, calls, 5)
+	assert.Equal(t, githubtest.Call{
+		Method: http.MethodPost, Path: "/repos/acme/pr-service/pulls/42/requested_reviewers", Token: "token", Reviewers: []string{"bob-gh"},
+	}, calls[0])
+	assert.Equal(t, http.MethodDelete, calls[1].Method)
+	assert.Equal(t, []string{"bob-gh"}, calls[1].Reviewers)
+	assert.Equal(t, []string{"carol-gh"}, calls[2].Reviewers)
+	assert.Equal(t, "/repos/acme/pr-service/issues/42/comments", calls[3].Path)
+	assert.Equal(t, "Reviewer @bob-gh was replaced by @carol-gh.", calls[3].Body)
+	assert.Equal(t, http.MethodDelete, calls[4].Method)
+	assert.Equal(t, []string{"carol-gh"}, calls[4].Reviewers)
+}
+
+type singleMessageStore struct {
+	msg       *domain.OutboxMessage
+	published bool
+}
+
+func (s *singleMessageStore) PendingAggregates(context.Context, int) ([]string, error) {
+	if s.published {
+		return nil, nil
+	}
+	return []string{s.msg.AggregateID}, nil
+}
+
+func (s *singleMessageStore) Claim(context.Context, string, int, time.Time) ([]*domain.OutboxMessage, error) {
+	return []*domain.OutboxMessage{s.msg}, nil
+}
+
+func (s *singleMessageStore) Release(context.Context, []int64) error { return nil }
+
+func (s *singleMessageStore) MarkDelivered(_ context.Context, _ int64, sink string) error {
+	s.msg.Delivered = append(s.msg.Delivered, sink)
+	return nil
+}
+
+func (s *singleMessageStore) MarkPublished(context.Context, int64) error {
+	s.published = true
+	return nil
+}
+
+func (s *singleMessageStore) MarkFailed(context.Context, int64, string, time.Time, bool) error {
+	s.msg.Attempts++
+	return nil
+}
+
+type flakySink struct{ failures int }
+
+func (s *flakySink) Name() string { return "webhook" }
+
+func (s *flakySink) Deliver(context.Context, domain.Event) error {
+	if s.failures > 0 {
+		s.failures--
+		return errors.New("unavailable")
+	}
+	return nil
+}
+
+func TestSink_NotRepeatedOnOtherSinkFailure(t *testing.T) {
+	server := githubtest.NewServer()
+	defer server.Close()
+
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	repo := &memoryForge{
+		refs: map[string]*domain.ForgePullRequest{
+			"github:acme/pr-service#42": {Provider: domain.ForgeGitHub, Repository: "acme/pr-service", Number: 42},
+		},
+	}
+	sink := NewSink(log, repo, map[domain.ForgeProvider]ForgeClient{
+		domain.ForgeGitHub: github.NewClient(server.URL, "token", server.Client()),
+	})
+
+	event := domain.NewEvent(domain.EventPRUnderstaffed)
+	event.PullRequest = &domain.PullRequest{ID: "github:acme/pr-service#42"}
+	store := &singleMessageStore{msg: &domain.OutboxMessage{ID: 1, AggregateID: event.PullRequest.ID, Event: event}}
+	relay := outbox.NewRelay(log, store, []outbox.Sink{sink, &flakySink{failures: 2}}, outbox.Options{
+		BatchSize:   1,
+		MaxAttempts: 5,
+	})
+
+	for range 3 {
+		require.NoError(t, relay.Poll(context.Background()))
+	}
+	require.True(t, store.published)
+	// Вебхук доставлен с третьей попытки, а комментарий в форже оставлен один раз.
+	assert.Len(t, server.Calls(), 1)
+}
@@ -0,0 +1,171 @@
+package ingest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/LeoUraltsev/PRReviewerService/internal/domain"
+	"go.opentelemetry.io/otel"
+)
+
+var tracer = otel.Tracer("github.com/LeoUraltsev/PRReviewerService/internal/service/ingest")
+
+type PullRequests interface {
+	ImportPullRequest(ctx context.Context, ref *domain.ForgePullRequest, prName string, authorID string, size *domain.PullRequestSize) (*domain.PullRequest, error)
+	SyncMergedPullRequest(ctx context.Context, prID string) (*domain.PullRequest, error)
+	ClosePullRequest(ctx context.Context, prID string, version int64) (*domain.PullRequest, error)
+	ReopenPullRequest(ctx context.Context, prID string, version int64) (*domain.PullRequest, error)
+}
+
+type RepoForge interface {
+	LinkAccount(ctx context.Context, account *domain.ForgeAccount) error
+	GetUserIDByLogin(ctx context.Context, provider domain.ForgeProvider, login string) (string, error)
+	AddRepository(ctx context.Context, provider domain.ForgeProvider, fullName string) (*domain.ForgeRepository, error)
+	RepositoryExists(ctx context.Context, provider domain.ForgeProvider, fullName string) (bool, error)
+	GetPullRequestRef(ctx context.Context, provider domain.ForgeProvider, repository string, number int64) (*domain.ForgePullRequest, error)
+}
+
+// Service переводит события PR из любого форжа (GitHub, GitLab) в вызовы сервиса PR.
+// Разбор формата конкретного провайдера остаётся в HTTP-обработчиках.
+type Service struct {
+	repo RepoForge
+	prs  PullRequests
+}
+
+func NewService(repo RepoForge, prs PullRequests) *Service {
+	return &Service{
+		repo: repo,
+		prs:  prs,
+	}
+}
+
+func (s *Service) LinkAccount(ctx context.Context, account *domain.ForgeAccount) (*domain.ForgeAccount, error) {
+	ctx, span := tracer.Start(ctx, "IngestService.LinkAccount")
+	defer span.End()
+
+	if err := s.repo.LinkAccount(ctx, account); err != nil {
+		return nil, err
+	}
+	return account, nil
+}
+
+func (s *Service) AddRepository(ctx context.Context, provider domain.ForgeProvider, fullName string) (*domain.ForgeRepository, error) {
+	ctx, span := tracer.Start(ctx, "IngestService.AddRepository")
+	defer span.End()
+
+	return s.repo.AddRepository(ctx, provider, strings.ToLower(fullName))
+}
+
+// HandlePullRequest применяет событие форжа. События незарегистрированных репозиториев
+// и действия, которые сервис не отслеживает, пропускаются без ошибки.
+func (s *Service) HandlePullRequest(ctx context.Context, event *domain.ForgePullRequestEvent) (*domain.IngestResult, error) {
+	ctx, span := tracer.Start(ctx, "IngestService.HandlePullRequest")
+	defer span.End()
+
+	// Имена репозиториев в форжах регистронезависимы.
+	event.Repository = strings.ToLower(event.Repository)
+
+	known, err := s.repo.RepositoryExists(ctx, event.Provider, event.Repository)
+	if err != nil {
+		return nil, err
+	}
+	if !known {
+		return ignored("", fmt.Sprintf("repository %s is not registered", event.Repository)), nil
+	}
+
+	switch event.Action {
+	case domain.ForgeActionOpened:
+		return s.open(ctx, event)
+	case domain.ForgeActionUpdated:
+		// Обновление PR, который ещё не отслеживается (например, открыт до подключения
+		// интеграции), начинает его отслеживать, если форж сообщил автора.
+		return s.open(ctx, event)
+	case domain.ForgeActionReopened:
+		return s.reopen(ctx, event)
+	case domain.ForgeActionClosed, domain.ForgeActionMerged:
+		return s.sync(ctx, event)
+	default:
+		return ignored("", fmt.Sprintf("action %s is not supported", event.Action)), nil
+	}
+}
+
+func (s *Service) open(ctx context.Context, event *domain.ForgePullRequestEvent) (*domain.IngestResult, error) {
+	ref, err := s.repo.GetPullRequestRef(ctx, event.Provider, event.Repository, event.Number)
+	if err == nil {
+		return ignored(ref.PullRequestID, "pull request is already tracked"), nil
+	}
+	if !errors.Is(err, domain.ErrForgePRNotFound) {
+		return nil, err
+	}
+	if event.AuthorLogin == "" {
+		return ignored("", "pull request author is unknown, it is imported when opened"), nil
+	}
+
+	authorID, err := s.repo.GetUserIDByLogin(ctx, event.Provider, event.AuthorLogin)
+	if err != nil {
+		return nil, fmt.Errorf("author %s: %w", event.AuthorLogin, err)
+	}
+
+	ref = &domain.ForgePullRequest{
+		Provider:      event.Provider,
+		Repository:    event.Repository,
+		Number:        event.Number,
+		PullRequestID: event.PullRequestID(),
+	}
+	if _, err = s.prs.ImportPullRequest(ctx, ref, event.Title, authorID, event.Size); err != nil {
+		return nil, err
+	}
+	return processed(ref.PullRequestID), nil
+}
+
+// reopen снова открывает отслеживаемый PR, а PR, который ещё не отслеживается, начинает отслеживать.
+func (s *Service) reopen(ctx context.Context, event *domain.ForgePullRequestEvent) (*domain.IngestResult, error) {
+	_, err := s.repo.GetPullRequestRef(ctx, event.Provider, event.Repository, event.Number)
+	if errors.Is(err, domain.ErrForgePRNotFound) {
+		return s.open(ctx, event)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return s.sync(ctx, event)
+}
+
+// sync применяет к отслеживаемому PR закрытие, повторное открытие или merge, уже случившиеся во форже;
+// версия PR при этом не проверяется. Переход, невозможный из текущего статуса PR в сервисе (например,
+// закрытие уже смерженного PR), пропускается: повторная доставка события ничего бы не изменила.
+func (s *Service) sync(ctx context.Context, event *domain.ForgePullRequestEvent) (*domain.IngestResult, error) {
+	ref, err := s.repo.GetPullRequestRef(ctx, event.Provider, event.Repository, event.Number)
+	if err != nil {
+		if errors.Is(err, domain.ErrForgePRNotFound) {
+			return ignored("", "pull request is not tracked"), nil
+		}
+		return nil, err
+	}
+
+	switch event.Action {
+	case domain.ForgeActionClosed:
+		_, err = s.prs.ClosePullRequest(ctx, ref.PullRequestID, 0)
+	case domain.ForgeActionReopened:
+		_, err = s.prs.ReopenPullRequest(ctx, ref.PullRequestID, 0)
+	default:
+		_, err = s.prs.SyncMergedPullRequest(ctx, ref.PullRequestID)
+	}
+	if err != nil {
+		var domainErr *domain.Error
+		if errors.As(err, &domainErr) && domainErr.Code == domain.CodeInvalidTransition {
+			return ignored(ref.PullRequestID, domainErr.Message), nil
+		}
+		return nil, err
+	}
+	return processed(ref.PullRequestID), nil
+}
+
+func processed(prID string) *domain.IngestResult {
+	return &domain.IngestResult{Processed: true, PullRequestID: prID}
+}
+
+func ignored(prID string, reason string) *domain.IngestResult {
+	return &domain.IngestResult{PullRequestID: prID, Reason: reason}
+}
@@ -0,0 +1,66 @@
+package out_of_office
+
+import (
+	"context"
+	"time"
+
+	"github.com/LeoUraltsev/PRReviewerService/internal/clock"
+	"github.com/LeoUraltsev/PRReviewerService/internal/domain"
+	"go.opentelemetry.io/otel"
+)
+
+var tracer = otel.Tracer("github.com/LeoUraltsev/PRReviewerService/internal/service/out_of_office")
+
+type RepoOutOfOffice interface {
+	Save(ctx context.Context, period *domain.OutOfOffice) (*domain.OutOfOffice, error)
+	ListByUser(ctx context.Context, userID string, from time.Time) ([]*domain.OutOfOffice, error)
+	Delete(ctx context.Context, id int64) error
+}
+
+type RepoUsers interface {
+	CheckExists(ctx context.Context, userID string) error
+}
+
+type Service struct {
+	repo      RepoOutOfOffice
+	repoUsers RepoUsers
+	clock     clock.Clock
+}
+
+func NewService(repo RepoOutOfOffice, users RepoUsers, clk clock.Clock) *Service {
+	return &Service{
+		repo:      repo,
+		repoUsers: users,
+		clock:     clk,
+	}
+}
+
+// AddOutOfOffice регистрирует период отсутствия пользователя. Период, который уже начался,
+// действует сразу: пользователь перестаёт назначаться ревьювером.
+func (s *Service) AddOutOfOffice(ctx context.Context, period *domain.OutOfOffice) (*domain.OutOfOffice, error) {
+	ctx, span := tracer.Start(ctx, "OutOfOfficeService.AddOutOfOffice")
+	defer span.End()
+
+	if err := period.Validate(s.clock.Now()); err != nil {
+		return nil, err
+	}
+	return s.repo.Save(ctx, period)
+}
+
+// GetOutOfOffice возвращает текущие и будущие периоды отсутствия пользователя.
+func (s *Service) GetOutOfOffice(ctx context.Context, userID string) ([]*domain.OutOfOffice, error) {
+	ctx, span := tracer.Start(ctx, "OutOfOfficeService.GetOutOfOffice")
+	defer span.End()
+
+	if err := s.repoUsers.CheckExists(ctx, userID); err != nil {
+		return nil, err
+	}
+	return s.repo.ListByUser(ctx, userID, s.clock.Now())
+}
+
+func (s *Service) DeleteOutOfOffice(ctx context.Context, id int64) error {
+	ctx, span := tracer.Start(ctx, "OutOfOfficeService.DeleteOutOfOffice")
+	defer span.End()
+
+	return s.repo.Delete(ctx, id)
+}
@@ -0,0 +1,127 @@
+package out_of_office
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/LeoUraltsev/PRReviewerService/internal/clock"
+	"github.com/LeoUraltsev/PRReviewerService/internal/domain"
+)
+
+// schedulerBatchSize — сколько начавшихся периодов обрабатывается за один проход.
+const schedulerBatchSize = 100
+
+type DueStore interface {
+	Due(ctx context.Context, at time.Time, limit int) ([]*domain.OutOfOffice, error)
+	MarkReassigned(ctx context.Context, id int64, at time.Time) error
+}
+
+type Reviews interface {
+	GetPRByUserID(ctx context.Context, userID string, filter domain.PullRequestFilter) ([]*domain.PullRequest, error)
+}
+
+type Reassigner interface {
+	ReassignReviewerPullRequest(ctx context.Context, prID string, version int64, reviewerID string) (*domain.PullRequest, string, error)
+}
+
+// Scheduler при начале периода отсутствия с ReassignReviews передаёт открытые ревью
+// пользователя другим ревьюверам; ревью, для которых не нашлось замены, остаются за пользователем.
+// Период помечается обработанным только после того, как переназначены все ревью, поэтому после сбоя
+// он обрабатывается снова на следующем проходе. Повтор, в том числе параллельный на другой реплике,
+// безопасен: ревью, уже переданные другим, у пользователя больше не числятся.
+type Scheduler struct {
+	log        *slog.Logger
+	store      DueStore
+	reviews    Reviews
+	reassigner Reassigner
+	clock      clock.Clock
+	interval   time.Duration
+}
+
+func NewScheduler(log *slog.Logger, store DueStore, reviews Reviews, reassigner Reassigner, clk clock.Clock, interval time.Duration) *Scheduler {
+	if interval <= 0 {
+		interval = time.Minute
+	}
+	return &Scheduler{
+		log:        log,
+		store:      store,
+		reviews:    reviews,
+		reassigner: reassigner,
+		clock:      clk,
+		interval:   interval,
+	}
+}
+
+// Run обрабатывает начавшиеся периоды, пока не отменён ctx.
+func (s *Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.Tick(ctx); err != nil && ctx.Err() == nil {
+			s.log.Error("failed to process out-of-office periods", "err", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Tick выполняет один проход планировщика.
+func (s *Scheduler) Tick(ctx context.Context) error {
+	ctx, span := tracer.Start(ctx, "OutOfOfficeScheduler.Tick")
+	defer span.End()
+
+	now := s.clock.Now()
+	due, err := s.store.Due(ctx, now, schedulerBatchSize)
+	if err != nil {
+		return err
+	}
+
+	for _, period := range due {
+		if !s.reassignReviews(ctx, period) {
+			continue
+		}
+		if err = s.store.MarkReassigned(ctx, period.ID, now); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// reassignReviews переназначает открытые ревью пользователя и сообщает, обработаны ли все они.
+func (s *Scheduler) reassignReviews(ctx context.Context, period *domain.OutOfOffice) bool {
+	log := s.log.With("user", period.UserID, "out_of_office", period.ID)
+
+	prs, err := s.reviews.GetPRByUserID(ctx, period.UserID, domain.PullRequestFilter{})
+	if err != nil {
+		log.Error("failed to load reviews for reassignment", "err", err)
+		return false
+	}
+	done := true
+	for _, pr := range prs {
+		if pr.Status != domain.Open {
+			continue
+		}
+		_, replacedBy, err := s.reassigner.ReassignReviewerPullRequest(ctx, pr.ID, 0, period.UserID)
+		if err != nil {
+			if errors.Is(err, domain.ErrNoCandidate) {
+				log.Warn("no replacement reviewer while user is out of office", "pr", pr.ID)
+				continue
+			}
+			if errors.Is(err, domain.ErrNotAssigned) {
+				// Ревью уже передано, например параллельным проходом другой реплики.
+				continue
+			}
+			log.Error("failed to reassign review", "pr", pr.ID, "err", err)
+			done = false
+			continue
+		}
+		log.Info("reassigned review for out-of-office user", "pr", pr.ID, "replaced_by", replacedBy)
+	}
+	return done
+}
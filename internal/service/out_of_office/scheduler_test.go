@@ -0,0 +1,99 @@
+package out_of_office
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/LeoUraltsev/PRReviewerService/internal/clock"
+	"github.com/LeoUraltsev/PRReviewerService/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type memoryPeriods struct {
+	periods []*domain.OutOfOffice
+	reviews map[string][]*domain.PullRequest
+	// reassigned — пары pr/ревьювер, переданные Reassigner.
+	reassigned []string
+	// failing — PR, переназначение которых завершается временной ошибкой.
+	failing map[string]bool
+}
+
+func (m *memoryPeriods) Due(_ context.Context, at time.Time, _ int) ([]*domain.OutOfOffice, error) {
+	var res []*domain.OutOfOffice
+	for _, p := range m.periods {
+		if p.ReassignReviews && p.ReassignedAt == nil && p.Active(at) {
+			res = append(res, p)
+		}
+	}
+	return res, nil
+}
+
+func (m *memoryPeriods) MarkReassigned(_ context.Context, id int64, at time.Time) error {
+	for _, p := range m.periods {
+		if p.ID == id && p.ReassignedAt == nil {
+			p.ReassignedAt = &at
+		}
+	}
+	return nil
+}
+
+func (m *memoryPeriods) GetPRByUserID(_ context.Context, userID string, _ domain.PullRequestFilter) ([]*domain.PullRequest, error) {
+	return slices.Clone(m.reviews[userID]), nil
+}
+
+func (m *memoryPeriods) ReassignReviewerPullRequest(_ context.Context, prID string, _ int64, reviewerID string) (*domain.PullRequest, string, error) {
+	if prID == "pr-stuck" {
+		return nil, "", domain.ErrNoCandidate
+	}
+	if m.failing[prID] {
+		return nil, "", errors.New("connection reset")
+	}
+	m.reassigned = append(m.reassigned, prID+"/"+reviewerID)
+	// Как и в хранилище, переданное ревью у прежнего ревьювера больше не числится.
+	m.reviews[reviewerID] = slices.DeleteFunc(m.reviews[reviewerID], func(pr *domain.PullRequest) bool { return pr.ID == prID })
+	return &domain.PullRequest{ID: prID}, "u9", nil
+}
+
+func TestScheduler_Tick(t *testing.T) {
+	start := time.Date(2026, 8, 1, 0, 0, 0, 0, time.UTC)
+	store := &memoryPeriods{
+		periods: []*domain.OutOfOffice{
+			{ID: 1, UserID: "u1", StartsAt: start, EndsAt: start.Add(72 * time.Hour), ReassignReviews: true},
+			{ID: 2, UserID: "u2", StartsAt: start, EndsAt: start.Add(72 * time.Hour)},
+		},
+		reviews: map[string][]*domain.PullRequest{
+			"u1": {
+				{ID: "pr-1", Status: domain.Open},
+				{ID: "pr-2", Status: domain.Merged},
+				{ID: "pr-stuck", Status: domain.Open},
+				{ID: "pr-flaky", Status: domain.Open},
+			},
+			"u2": {{ID: "pr-3", Status: domain.Open}},
+		},
+		failing: map[string]bool{"pr-flaky": true},
+	}
+	clk := clock.NewFake(start.Add(-time.Hour))
+	s := NewScheduler(slog.New(slog.NewTextHandler(io.Discard, nil)), store, store, store, clk, time.Minute)
+
+	require.NoError(t, s.Tick(context.Background()))
+	assert.Empty(t, store.reassigned, "period has not started yet")
+
+	clk.Advance(2 * time.Hour)
+	require.NoError(t, s.Tick(context.Background()))
+	assert.Equal(t, []string{"pr-1/u1"}, store.reassigned)
+	assert.Nil(t, store.periods[0].ReassignedAt, "period with a failed reassignment is retried")
+
+	store.failing = nil
+	require.NoError(t, s.Tick(context.Background()))
+	assert.Equal(t, []string{"pr-1/u1", "pr-flaky/u1"}, store.reassigned, "retry reassigns only what is left")
+	assert.NotNil(t, store.periods[0].ReassignedAt)
+
+	require.NoError(t, s.Tick(context.Background()))
+	assert.Equal(t, []string{"pr-1/u1", "pr-flaky/u1"}, store.reassigned, "processed period is not picked up again")
+}
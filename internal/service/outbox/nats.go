@@ -0,0 +1,148 @@
+package outbox
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/LeoUraltsev/PRReviewerService/internal/domain"
+	"github.com/LeoUraltsev/PRReviewerService/internal/service/webhook"
+)
+
+// NATSSink публикует события в NATS-совместимый брокер по текстовому протоколу
+// в subject "<prefix>.<тип события>". После каждой публикации отправляется PING,
+// и событие считается доставленным только после PONG от сервера.
+type NATSSink struct {
+	addr    string
+	prefix  string
+	timeout time.Duration
+
+	mu     sync.Mutex
+	conn   net.Conn
+	reader *bufio.Reader
+}
+
+// NewNATSSink принимает адрес вида nats://host:port.
+func NewNATSSink(rawURL, prefix string, timeout time.Duration) (*NATSSink, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid nats url: %w", err)
+	}
+	if u.Scheme != "nats" || u.Host == "" {
+		return nil, fmt.Errorf("invalid nats url %q", rawURL)
+	}
+	return &NATSSink{
+		addr:    u.Host,
+		prefix:  strings.TrimSuffix(prefix, "."),
+		timeout: timeout,
+	}, nil
+}
+
+func (s *NATSSink) Name() string {
+	return "nats"
+}
+
+func (s *NATSSink) Deliver(ctx context.Context, event domain.Event) error {
+	body, err := json.Marshal(webhook.NewPayload(event))
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.conn == nil {
+		if err = s.connect(ctx); err != nil {
+			return err
+		}
+	}
+	if err = s.publish(s.subject(event.Type), body); err != nil {
+		s.closeLocked()
+		return err
+	}
+	return nil
+}
+
+func (s *NATSSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.closeLocked()
+}
+
+func (s *NATSSink) subject(eventType domain.EventType) string {
+	if s.prefix == "" {
+		return eventType.String()
+	}
+	return s.prefix + "." + eventType.String()
+}
+
+func (s *NATSSink) connect(ctx context.Context) error {
+	dialer := net.Dialer{Timeout: s.timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", s.addr)
+	if err != nil {
+		return err
+	}
+	s.conn = conn
+	s.reader = bufio.NewReader(conn)
+
+	_ = conn.SetDeadline(time.Now().Add(s.timeout))
+	line, err := s.reader.ReadString('\n')
+	if err != nil {
+		s.closeLocked()
+		return err
+	}
+	if !strings.HasPrefix(line, "INFO") {
+		s.closeLocked()
+		return fmt.Errorf("unexpected nats greeting %q", strings.TrimSpace(line))
+	}
+
+	if _, err = conn.Write([]byte("CONNECT {\"verbose\":false,\"pedantic\":false,\"name\":\"pr-reviewer-service\"}\r\n")); err != nil {
+		s.closeLocked()
+		return err
+	}
+	return nil
+}
+
+func (s *NATSSink) publish(subject string, body []byte) error {
+	_ = s.conn.SetDeadline(time.Now().Add(s.timeout))
+
+	msg := fmt.Sprintf("PUB %s %d\r\n%s\r\nPING\r\n", subject, len(body), body)
+	if _, err := s.conn.Write([]byte(msg)); err != nil {
+		return err
+	}
+
+	for {
+		line, err := s.reader.ReadString('\n')
+		if err != nil {
+			return err
+		}
+		line = strings.TrimSpace(line)
+		switch {
+		case line == "PONG":
+			return nil
+		case line == "PING":
+			if _, err = s.conn.Write([]byte("PONG\r\n")); err != nil {
+				return err
+			}
+		case strings.HasPrefix(line, "-ERR"):
+			return errors.New(strings.TrimSpace(strings.TrimPrefix(line, "-ERR")))
+		}
+	}
+}
+
+func (s *NATSSink) closeLocked() error {
+	if s.conn == nil {
+		return nil
+	}
+	err := s.conn.Close()
+	s.conn = nil
+	s.reader = nil
+	return err
+}
@@ -0,0 +1,189 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/LeoUraltsev/PRReviewerService/internal/domain"
+	"go.opentelemetry.io/otel"
+)
+
+var tracer = otel.Tracer("github.com/LeoUraltsev/PRReviewerService/internal/service/outbox")
+
+// maxBackoff ограничивает паузу между повторными публикациями одного события.
+const maxBackoff = 10 * time.Minute
+
+// defaultLeaseTimeout — сколько события PR остаются за репликой, если она упала, не успев их обработать.
+const defaultLeaseTimeout = 5 * time.Minute
+
+type Store interface {
+	PendingAggregates(ctx context.Context, limit int) ([]string, error)
+	Claim(ctx context.Context, aggregateID string, limit int, until time.Time) ([]*domain.OutboxMessage, error)
+	Release(ctx context.Context, ids []int64) error
+	MarkDelivered(ctx context.Context, id int64, sink string) error
+	MarkPublished(ctx context.Context, id int64) error
+	MarkFailed(ctx context.Context, id int64, lastErr string, nextAttemptAt time.Time, dead bool) error
+}
+
+// Sink — получатель событий из outbox. Доставка at-least-once и отслеживается для каждого sink
+// отдельно по Name: при сбое одного sink событие повторно отправляется только тем, кто его ещё не получил.
+type Sink interface {
+	Name() string
+	Deliver(ctx context.Context, event domain.Event) error
+}
+
+type Options struct {
+	PollInterval time.Duration
+	BatchSize    int
+	MaxAttempts  int
+	Backoff      time.Duration
+	// LeaseTimeout должен превышать самую долгую доставку пачки событий, иначе их заберёт другая реплика.
+	LeaseTimeout time.Duration
+}
+
+// Relay публикует события из outbox. События одного PR обрабатываются строго по порядку:
+// relay арендует их на LeaseTimeout, поэтому несколько реплик не отправляют одно событие одновременно,
+// а сама доставка выполняется вне транзакции базы.
+type Relay struct {
+	log   *slog.Logger
+	store Store
+	sinks []Sink
+	opts  Options
+	now   func() time.Time
+}
+
+func NewRelay(log *slog.Logger, store Store, sinks []Sink, opts Options) *Relay {
+	if opts.BatchSize < 1 {
+		opts.BatchSize = 1
+	}
+	if opts.MaxAttempts < 1 {
+		opts.MaxAttempts = 1
+	}
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = time.Second
+	}
+	if opts.LeaseTimeout <= 0 {
+		opts.LeaseTimeout = defaultLeaseTimeout
+	}
+	return &Relay{
+		log:   log,
+		store: store,
+		sinks: sinks,
+		opts:  opts,
+		now:   time.Now,
+	}
+}
+
+// Run опрашивает outbox, пока не отменён ctx.
+func (r *Relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.opts.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := r.Poll(ctx); err != nil && ctx.Err() == nil {
+			r.log.Error("failed to relay outbox", "err", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Poll выполняет один проход по PR, у которых есть события к публикации.
+func (r *Relay) Poll(ctx context.Context) error {
+	ctx, span := tracer.Start(ctx, "OutboxRelay.Poll")
+	defer span.End()
+
+	aggregates, err := r.store.PendingAggregates(ctx, r.opts.BatchSize)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, aggregateID := range aggregates {
+		messages, err := r.store.Claim(ctx, aggregateID, r.opts.BatchSize, r.now().Add(r.opts.LeaseTimeout))
+		if err != nil {
+			errs = append(errs, fmt.Errorf("aggregate %s: %w", aggregateID, err))
+			continue
+		}
+		if len(messages) == 0 {
+			r.log.Debug("outbox aggregate is leased by another relay", "aggregate_id", aggregateID)
+			continue
+		}
+		if err = r.relay(ctx, messages); err != nil {
+			errs = append(errs, fmt.Errorf("aggregate %s: %w", aggregateID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (r *Relay) relay(ctx context.Context, messages []*domain.OutboxMessage) error {
+	for i, msg := range messages {
+		err := r.publish(ctx, msg)
+		if err == nil {
+			if err = r.store.MarkPublished(ctx, msg.ID); err != nil {
+				return err
+			}
+			continue
+		}
+
+		attempts := msg.Attempts + 1
+		dead := attempts >= r.opts.MaxAttempts
+		r.log.Warn("failed to publish outbox event",
+			"event_id", msg.Event.ID, "event", msg.Event.Type, "attempt", attempts, "dead", dead, "err", err)
+
+		if markErr := r.store.MarkFailed(ctx, msg.ID, err.Error(), r.now().Add(r.backoff(attempts)), dead); markErr != nil {
+			return markErr
+		}
+		if !dead {
+			// Следующие события PR ждут, пока не будет опубликовано это, чтобы не нарушить порядок.
+			return r.release(ctx, messages[i+1:])
+		}
+	}
+	return nil
+}
+
+// publish отправляет событие в sink, которые его ещё не получили, и запоминает каждую успешную доставку.
+func (r *Relay) publish(ctx context.Context, msg *domain.OutboxMessage) error {
+	var errs []error
+	for _, sink := range r.sinks {
+		if slices.Contains(msg.Delivered, sink.Name()) {
+			continue
+		}
+		if err := sink.Deliver(ctx, msg.Event); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", sink.Name(), err))
+			continue
+		}
+		if err := r.store.MarkDelivered(ctx, msg.ID, sink.Name()); err != nil {
+			errs = append(errs, fmt.Errorf("%s: mark delivered: %w", sink.Name(), err))
+			continue
+		}
+		msg.Delivered = append(msg.Delivered, sink.Name())
+	}
+	return errors.Join(errs...)
+}
+
+func (r *Relay) release(ctx context.Context, messages []*domain.OutboxMessage) error {
+	if len(messages) == 0 {
+		return nil
+	}
+	ids := make([]int64, 0, len(messages))
+	for _, msg := range messages {
+		ids = append(ids, msg.ID)
+	}
+	return r.store.Release(ctx, ids)
+}
+
+func (r *Relay) backoff(attempts int) time.Duration {
+	d := r.opts.Backoff
+	for i := 1; i < attempts && d < maxBackoff; i++ {
+		d *= 2
+	}
+	return min(d, maxBackoff)
+}
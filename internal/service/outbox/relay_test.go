@@ -0,0 +1,230 @@
+package outbox
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/LeoUraltsev/PRReviewerService/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type memoryStore struct {
+	messages  []*domain.OutboxMessage
+	published []int64
+	failed    map[int64]bool
+	locked    map[string]bool
+	released  []int64
+}
+
+func (m *memoryStore) PendingAggregates(_ context.Context, limit int) ([]string, error) {
+	var ids []string
+	seen := map[string]bool{}
+	for _, msg := range m.messages {
+		if !seen[msg.AggregateID] && len(ids) < limit {
+			seen[msg.AggregateID] = true
+			ids = append(ids, msg.AggregateID)
+		}
+	}
+	return ids, nil
+}
+
+func (m *memoryStore) Claim(_ context.Context, aggregateID string, limit int, _ time.Time) ([]*domain.OutboxMessage, error) {
+	if m.locked[aggregateID] {
+		return nil, nil
+	}
+	var res []*domain.OutboxMessage
+	for _, msg := range m.messages {
+		if msg.AggregateID == aggregateID && len(res) < limit {
+			res = append(res, msg)
+		}
+	}
+	return res, nil
+}
+
+func (m *memoryStore) Release(_ context.Context, ids []int64) error {
+	m.released = append(m.released, ids...)
+	return nil
+}
+
+func (m *memoryStore) MarkDelivered(_ context.Context, id int64, sink string) error {
+	for _, msg := range m.messages {
+		if msg.ID == id {
+			msg.Delivered = append(msg.Delivered, sink)
+		}
+	}
+	return nil
+}
+
+func (m *memoryStore) MarkPublished(_ context.Context, id int64) error {
+	m.published = append(m.published, id)
+	m.remove(id)
+	return nil
+}
+
+func (m *memoryStore) MarkFailed(_ context.Context, id int64, _ string, _ time.Time, dead bool) error {
+	m.failed[id] = dead
+	for _, msg := range m.messages {
+		if msg.ID == id {
+			msg.Attempts++
+		}
+	}
+	if dead {
+		m.remove(id)
+	}
+	return nil
+}
+
+func (m *memoryStore) remove(id int64) {
+	for i, msg := range m.messages {
+		if msg.ID == id {
+			m.messages = append(m.messages[:i], m.messages[i+1:]...)
+			return
+		}
+	}
+}
+
+type recordingSink struct {
+	name      string
+	delivered []string
+	fail      map[string]bool
+}
+
+func (s *recordingSink) Name() string {
+	if s.name == "" {
+		return "recording"
+	}
+	return s.name
+}
+
+func (s *recordingSink) Deliver(_ context.Context, event domain.Event) error {
+	if s.fail[event.ID] {
+		return errors.New("unavailable")
+	}
+	s.delivered = append(s.delivered, event.ID)
+	return nil
+}
+
+func newMessage(id int64, aggregateID string) *domain.OutboxMessage {
+	return &domain.OutboxMessage{
+		ID:          id,
+		AggregateID: aggregateID,
+		Event:       domain.Event{ID: fmt.Sprintf("%s-%d", aggregateID, id), Type: domain.EventPRCreated},
+	}
+}
+
+func TestRelay_Poll(t *testing.T) {
+	store := &memoryStore{
+		messages: []*domain.OutboxMessage{
+			newMessage(1, "pr-1"),
+			newMessage(2, "pr-2"),
+			newMessage(3, "pr-1"),
+			newMessage(4, "pr-3"),
+		},
+		failed: map[int64]bool{},
+		locked: map[string]bool{"pr-3": true},
+	}
+	sink := &recordingSink{fail: map[string]bool{"pr-1-1": true}}
+	relay := NewRelay(slog.New(slog.NewTextHandler(io.Discard, nil)), store, []Sink{sink}, Options{
+		BatchSize:   10,
+		MaxAttempts: 2,
+		Backoff:     time.Second,
+	})
+
+	require.NoError(t, relay.Poll(context.Background()))
+	// Первое событие pr-1 не доставлено, поэтому второе ждёт своей очереди; pr-3 занят другой репликой.
+	assert.Equal(t, []string{"pr-2-2"}, sink.delivered)
+	assert.Equal(t, map[int64]bool{1: false}, store.failed)
+	assert.Equal(t, []int64{3}, store.released)
+
+	require.NoError(t, relay.Poll(context.Background()))
+	// После последней попытки событие откладывается навсегда и очередь PR продолжается.
+	assert.Equal(t, map[int64]bool{1: true}, store.failed)
+	assert.Equal(t, []string{"pr-2-2", "pr-1-3"}, sink.delivered)
+	assert.Equal(t, []int64{2, 3}, store.published)
+}
+
+func TestRelay_Poll_RetriesOnlyFailedSinks(t *testing.T) {
+	store := &memoryStore{
+		messages: []*domain.OutboxMessage{newMessage(1, "pr-1")},
+		failed:   map[int64]bool{},
+	}
+	forge := &recordingSink{name: "forge"}
+	webhook := &recordingSink{name: "webhook", fail: map[string]bool{"pr-1-1": true}}
+	relay := NewRelay(slog.New(slog.NewTextHandler(io.Discard, nil)), store, []Sink{forge, webhook}, Options{
+		BatchSize:   10,
+		MaxAttempts: 3,
+		Backoff:     time.Second,
+	})
+
+	require.NoError(t, relay.Poll(context.Background()))
+	assert.Equal(t, map[int64]bool{1: false}, store.failed)
+
+	webhook.fail = nil
+	require.NoError(t, relay.Poll(context.Background()))
+	// Повтор после сбоя webhook не отправляет событие в forge второй раз.
+	assert.Equal(t, []string{"pr-1-1"}, forge.delivered)
+	assert.Equal(t, []string{"pr-1-1"}, webhook.delivered)
+	assert.Equal(t, []int64{1}, store.published)
+}
+
+func TestRelay_Backoff(t *testing.T) {
+	relay := NewRelay(slog.Default(), nil, nil, Options{Backoff: time.Second})
+	assert.Equal(t, time.Second, relay.backoff(1))
+	assert.Equal(t, 4*time.Second, relay.backoff(3))
+	assert.Equal(t, maxBackoff, relay.backoff(64))
+}
+
+func TestNATSSink_Deliver(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer ln.Close()
+
+	received := make(chan []string, 1)
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		_, _ = conn.Write([]byte("INFO {\"server_id\":\"test\"}\r\n"))
+
+		r := bufio.NewReader(conn)
+		var lines []string
+		for {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				return
+			}
+			line = strings.TrimSpace(line)
+			if line == "PING" {
+				_, _ = conn.Write([]byte("PONG\r\n"))
+				received <- lines
+				return
+			}
+			lines = append(lines, line)
+		}
+	}()
+
+	sink, err := NewNATSSink("nats://"+ln.Addr().String(), "pr.events", time.Second)
+	require.NoError(t, err)
+	defer sink.Close()
+
+	event := domain.NewEvent(domain.EventPRMerged)
+	event.PullRequest = &domain.PullRequest{ID: "pr-1", Status: domain.Merged}
+	require.NoError(t, sink.Deliver(context.Background(), event))
+
+	lines := <-received
+	require.Len(t, lines, 3)
+	assert.True(t, strings.HasPrefix(lines[0], "CONNECT "))
+	assert.True(t, strings.HasPrefix(lines[1], "PUB pr.events.pr.merged "))
+	assert.Contains(t, lines[2], `"pull_request_id":"pr-1"`)
+}
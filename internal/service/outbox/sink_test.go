@@ -0,0 +1,52 @@
+package outbox
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/LeoUraltsev/PRReviewerService/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriterSink_WritesWebhookPayload(t *testing.T) {
+	var buf bytes.Buffer
+	sink := NewWriterSink("test", &buf)
+
+	event := domain.Event{
+		ID:         "ev-1",
+		Type:       domain.EventReviewSubmitted,
+		OccurredAt: time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC),
+		PullRequest: &domain.PullRequest{
+			ID:                "pr-1",
+			Name:              "Add search",
+			AuthorID:          "u1",
+			Status:            domain.Open,
+			AssignedReviewers: []string{"u2"},
+			CreatedAt:         time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC),
+		},
+		ReviewerID: "u2",
+		Verdict:    domain.VerdictChangesRequested,
+	}
+	require.NoError(t, sink.Deliver(context.Background(), event))
+
+	assert.JSONEq(t, `{
+		"id": "ev-1",
+		"type": "review.submitted",
+		"occurred_at": "2026-01-01T12:00:00Z",
+		"pull_request": {
+			"pull_request_id": "pr-1",
+			"pull_request_name": "Add search",
+			"author_id": "u1",
+			"status": "OPEN",
+			"assigned_reviewers": ["u2"],
+			"need_more_reviewers": false,
+			"createdAt": "2026-01-01T10:00:00Z",
+			"mergedAt": null
+		},
+		"reviewer_id": "u2",
+		"verdict": "CHANGES_REQUESTED"
+	}`, buf.String())
+}
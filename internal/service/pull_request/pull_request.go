@@ -2,10 +2,16 @@ package pull_request
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"slices"
+	"strings"
+	"time"
 
+	"github.com/LeoUraltsev/PRReviewerService/internal/clock"
 	"github.com/LeoUraltsev/PRReviewerService/internal/domain"
+	"go.opentelemetry.io/otel"
 )
 
 /*
@@ -14,148 +20,768 @@ import (
 2. Нейминг интерфейсов пошел погулять
 */
 
+var tracer = otel.Tracer("github.com/LeoUraltsev/PRReviewerService/internal/service/pull_request")
+
+// RepoPR сохраняет изменения PR вместе с событиями о них (transactional outbox). Изменяющие методы
+// принимают ожидаемую версию PR version и применяют изменение, только пока версия PR равна ей; 0 — без проверки.
 type RepoPR interface {
-	Save(ctx context.Context, pullRequest *domain.PullRequest) error
+	Save(ctx context.Context, pullRequest *domain.PullRequest, events []domain.Event) error
 	GetByID(ctx context.Context, prID string) (*domain.PullRequest, error)
-	UpdateStatus(ctx context.Context, id string, status domain.Status) (*domain.PullRequest, error)
-	Reassign(ctx context.Context, prID string, oldUserID string, newUserID string) (*domain.PullRequest, error)
+	UpdateStatus(ctx context.Context, id string, version int64, from domain.Status, status domain.Status, audit []domain.AuditEntry, events []domain.Event) (*domain.PullRequest, error)
+	Reassign(ctx context.Context, prID string, version int64, oldUserID string, newUserID string, events []domain.Event) (*domain.PullRequest, error)
+	SubmitReview(ctx context.Context, prID string, version int64, review *domain.Review, events []domain.Event) (*domain.PullRequest, error)
+	AssignReviewers(ctx context.Context, pr *domain.PullRequest, version int64, events []domain.Event) (*domain.PullRequest, error)
+	ChangeReviewers(ctx context.Context, pr *domain.PullRequest, version int64, added []string, removed []string, audit []domain.AuditEntry, events []domain.Event) (*domain.PullRequest, error)
+	UpdateMetadata(ctx context.Context, pr *domain.PullRequest, version int64, added []string, removed []string, events []domain.Event) (*domain.PullRequest, error)
+	GetAudit(ctx context.Context, prID string) ([]*domain.AuditEntry, error)
 }
 
 type UserRepo interface {
 	GetInactiveUsers(ctx context.Context, teamName string, limit int, excludeUsers []string) ([]*domain.User, error)
+	GetInactiveUsersByRepository(ctx context.Context, repositoryID string, limit int, excludeUsers []string) ([]*domain.User, error)
+	GetActiveUsersByHandle(ctx context.Context, handle string, excludeUsers []string) ([]*domain.User, error)
+	GetSkills(ctx context.Context, userIDs []string) (map[string][]string, error)
+	GetOutOfOfficeUserIDs(ctx context.Context, at time.Time) ([]string, error)
+	GetWorkingHours(ctx context.Context, userIDs []string) (map[string]*domain.WorkingHours, error)
 	GetByID(ctx context.Context, userID string) (*domain.User, error)
-	UpdateIsActive(ctx context.Context, userID string, active bool) (*domain.User, error)
+	GetCapacity(ctx context.Context, userID string) (*domain.ReviewerCapacity, error)
+}
+
+type RepoRepository interface {
+	GetRepository(ctx context.Context, repositoryID string) (*domain.Repository, error)
+	GetCodeOwners(ctx context.Context, repositoryID string) (*domain.CodeOwnersFile, error)
+}
+
+type TeamRepo interface {
+	GetMergePolicy(ctx context.Context, teamName string) (domain.MergePolicy, error)
+	GetReviewRules(ctx context.Context, teamName string) ([]domain.ReviewRule, error)
 }
 
+// Service управляет PR и их ревьюверами. Изменяющие методы принимают ожидаемую версию PR version
+// из If-Match: изменение применяется, только пока версия PR равна ей, иначе возвращается
+// ErrVersionMismatch; 0 — без проверки.
 type Service struct {
-	repoPR   RepoPR
-	repoUser UserRepo
+	repoPR         RepoPR
+	repoUser       UserRepo
+	repoRepository RepoRepository
+	repoTeam       TeamRepo
+	clock          clock.Clock
 }
 
-func NewService(pr RepoPR, user UserRepo) *Service {
+func NewService(pr RepoPR, user UserRepo, repositories RepoRepository, teams TeamRepo, clk clock.Clock) *Service {
 	return &Service{
-		repoPR:   pr,
-		repoUser: user,
+		repoPR:         pr,
+		repoUser:       user,
+		repoRepository: repositories,
+		repoTeam:       teams,
+		clock:          clk,
 	}
 }
 
-func (s *Service) SavePullRequest(ctx context.Context, prID string, prName string, authorID string) (*domain.PullRequest, error) {
-	user, err := s.repoUser.GetByID(ctx, authorID)
+// SavePullRequest создаёт PR из draft: учитываются ID, Name, AuthorID, Description, Labels, Priority, Size,
+// RepositoryID и ChangedFiles для выбора ревьюверов по CODEOWNERS и RequiredSkills.
+// Если draft.Status — DRAFT, PR создаётся черновиком без ревьюверов.
+func (s *Service) SavePullRequest(ctx context.Context, draft *domain.PullRequest) (*domain.PullRequest, error) {
+	ctx, span := tracer.Start(ctx, "PullRequestService.SavePullRequest")
+	defer span.End()
+
+	requiredSkills, err := domain.NormalizeSkills(draft.RequiredSkills)
+	if err != nil {
+		return nil, err
+	}
+	// Описание, метки и приоритет проверяются и нормализуются так же, как при изменении PR.
+	meta := &domain.PullRequest{Priority: domain.PriorityNormal}
+	update := &domain.PullRequestUpdate{Description: &draft.Description, Labels: &draft.Labels}
+	if draft.Priority != "" {
+		update.Priority = &draft.Priority
+	}
+	if err = update.Apply(meta); err != nil {
+		return nil, err
+	}
+
+	return s.create(ctx, &domain.PullRequest{
+		ID:             draft.ID,
+		Name:           draft.Name,
+		AuthorID:       draft.AuthorID,
+		Description:    meta.Description,
+		Labels:         meta.Labels,
+		Priority:       meta.Priority,
+		RepositoryID:   draft.RepositoryID,
+		ChangedFiles:   draft.ChangedFiles,
+		RequiredSkills: requiredSkills,
+		Size:           draft.Size,
+		Status:         draft.Status,
+	})
+}
+
+// ImportPullRequest создаёт PR по событию из внешнего форжа. Идентификатор PR берётся из ссылки,
+// а сама ссылка сохраняется вместе с PR. size — объём изменений, если форж его сообщает, иначе nil.
+func (s *Service) ImportPullRequest(ctx context.Context, ref *domain.ForgePullRequest, prName string, authorID string, size *domain.PullRequestSize) (*domain.PullRequest, error) {
+	ctx, span := tracer.Start(ctx, "PullRequestService.ImportPullRequest")
+	defer span.End()
+
+	return s.create(ctx, &domain.PullRequest{
+		ID:       ref.PullRequestID,
+		Name:     prName,
+		AuthorID: authorID,
+		Size:     size,
+		Forge:    ref,
+	})
+}
+
+func (s *Service) create(ctx context.Context, draft *domain.PullRequest) (*domain.PullRequest, error) {
+	if draft.Size != nil {
+		if err := draft.Size.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	author, err := s.repoUser.GetByID(ctx, draft.AuthorID)
 	if err != nil {
 		return nil, err
 	}
 
-	slog.Info("call SavePullRequest")
-	needMoreReviewers := false
-	countUsers := 2
-	excludeUsers := []string{authorID}
-	users, err := s.repoUser.GetInactiveUsers(ctx, user.TeamName, countUsers, excludeUsers)
+	repo, err := s.getRepository(ctx, draft.RepositoryID)
 	if err != nil {
 		return nil, err
 	}
 
-	if len(users) < countUsers {
-		needMoreReviewers = true
+	pr := &domain.PullRequest{
+		ID:             draft.ID,
+		Name:           draft.Name,
+		AuthorID:       draft.AuthorID,
+		Description:    draft.Description,
+		Labels:         draft.Labels,
+		Priority:       draft.Priority,
+		Status:         domain.Open,
+		RepositoryID:   draft.RepositoryID,
+		ChangedFiles:   draft.ChangedFiles,
+		RequiredSkills: draft.RequiredSkills,
+		Size:           draft.Size,
+		Forge:          draft.Forge,
 	}
+	if pr.Priority == "" {
+		pr.Priority = domain.PriorityNormal
+	}
+	events := []domain.Event{domain.NewEvent(domain.EventPRCreated)}
 
-	reviewersIDs := make([]string, 0, len(users))
-	for _, v := range users {
-		_, err = s.repoUser.UpdateIsActive(ctx, v.UserID, false)
-		if err != nil {
-			slog.Warn("failed to update isActive for PR reviewer", "user", v.UserID)
+	var assignments []domain.ReviewerAssignment
+	if draft.Status == domain.Draft {
+		pr.Status = domain.Draft
+	} else {
+		var assigned []domain.Event
+		if assignments, assigned, err = s.staff(ctx, pr, repo, author); err != nil {
+			return nil, err
 		}
-		reviewersIDs = append(reviewersIDs, v.UserID)
+		events = append(events, assigned...)
 	}
 
-	err = s.repoPR.Save(ctx, &domain.PullRequest{
-		ID:                prID,
-		Name:              prName,
-		AuthorID:          authorID,
-		Status:            domain.Open,
-		AssignedReviewers: reviewersIDs,
-		NeedMoreReviewers: needMoreReviewers,
-	})
+	if err = s.repoPR.Save(ctx, pr, events); err != nil {
+		return nil, err
+	}
+	res, err := s.repoPR.GetByID(ctx, pr.ID)
 	if err != nil {
+		slog.Error("get pr by id failed", "err", err)
 		return nil, err
 	}
-	slog.Info("save pull request")
-	slog.Info("get pr by id")
-	pr, err := s.repoPR.GetByID(ctx, prID)
+	res.Assignments = assignments
+
+	return res, nil
+}
+
+// staff выбирает ревьюверов PR, записывает их в pr вместе с need_more_reviewers и непокрытыми
+// навыками и возвращает причины выбора и события о назначении. Перед выбором к PR применяются
+// правила ревью команды автора: они добавляют требуемые навыки и задают срок ревью.
+func (s *Service) staff(ctx context.Context, pr *domain.PullRequest, repo *domain.Repository, author *domain.User) ([]domain.ReviewerAssignment, []domain.Event, error) {
+	rules, err := s.repoTeam.GetReviewRules(ctx, author.TeamName)
+	if err != nil && !errors.Is(err, domain.ErrTeamNotFound) {
+		return nil, nil, err
+	}
+	domain.ApplyReviewRules(pr, rules, s.clock.Now())
+
+	assignments, err := s.selectReviewers(ctx, pr, repo, author)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pr.AssignedReviewers = make([]string, 0, len(assignments))
+	for _, a := range assignments {
+		pr.AssignedReviewers = append(pr.AssignedReviewers, a.UserID)
+	}
+	pr.NeedMoreReviewers = len(assignments) < reviewersCount(pr, repo)
+	pr.MissingSkills = missingSkills(pr.RequiredSkills, assignments)
+
+	var events []domain.Event
+	for _, reviewerID := range pr.AssignedReviewers {
+		event := domain.NewEvent(domain.EventReviewerAssigned)
+		event.ReviewerID = reviewerID
+		events = append(events, event)
+	}
+	if pr.NeedMoreReviewers {
+		events = append(events, domain.NewEvent(domain.EventPRUnderstaffed))
+	}
+	return assignments, events, nil
+}
+
+// ReadyPullRequest переводит черновик в OPEN и назначает ему ревьюверов так же, как при создании PR.
+// Для уже открытого PR ничего не меняет.
+func (s *Service) ReadyPullRequest(ctx context.Context, prID string, version int64) (*domain.PullRequest, error) {
+	ctx, span := tracer.Start(ctx, "PullRequestService.ReadyPullRequest")
+	defer span.End()
+
+	pr, err := s.forUpdate(ctx, prID, version)
 	if err != nil {
-		slog.Error("get pr by id failed", err)
 		return nil, err
 	}
-	return pr, nil
+	switch pr.Status {
+	case domain.Open:
+		return pr, nil
+	case domain.Draft:
+	default:
+		return nil, domain.ErrPRNotDraft
+	}
+	return s.open(ctx, pr, version, domain.NewEvent(domain.EventPRReady))
 }
 
-func (s *Service) MergePullRequest(ctx context.Context, prID string) (*domain.PullRequest, error) {
-	pr, err := s.repoPR.GetByID(ctx, prID)
+// ClosePullRequest закрывает черновик или открытый PR без merge; ревью закрытого PR перестают
+// учитываться в нагрузке ревьюверов. Повторное закрытие ничего не меняет.
+func (s *Service) ClosePullRequest(ctx context.Context, prID string, version int64) (*domain.PullRequest, error) {
+	ctx, span := tracer.Start(ctx, "PullRequestService.ClosePullRequest")
+	defer span.End()
+
+	pr, err := s.forUpdate(ctx, prID, version)
 	if err != nil {
 		return nil, err
 	}
+	if pr.Status == domain.Closed {
+		return pr, nil
+	}
+	from := pr.Status
+	if err = pr.Transition(domain.Closed); err != nil {
+		return nil, err
+	}
+	return s.repoPR.UpdateStatus(ctx, pr.ID, version, from, pr.Status, nil, []domain.Event{domain.NewEvent(domain.EventPRClosed)})
+}
+
+// ReopenPullRequest снова открывает закрытый PR с прежними ревьюверами; PR, закрытому черновиком,
+// ревьюверы назначаются заново. Для открытого PR ничего не меняет.
+func (s *Service) ReopenPullRequest(ctx context.Context, prID string, version int64) (*domain.PullRequest, error) {
+	ctx, span := tracer.Start(ctx, "PullRequestService.ReopenPullRequest")
+	defer span.End()
 
+	pr, err := s.forUpdate(ctx, prID, version)
+	if err != nil {
+		return nil, err
+	}
+	switch pr.Status {
+	case domain.Open:
+		return pr, nil
+	case domain.Closed:
+	default:
+		return nil, domain.ErrPRNotClosed
+	}
+
+	event := domain.NewEvent(domain.EventPRReopened)
+	if len(pr.AssignedReviewers) == 0 {
+		return s.open(ctx, pr, version, event)
+	}
+	if err = pr.Transition(domain.Open); err != nil {
+		return nil, err
+	}
+	return s.repoPR.UpdateStatus(ctx, pr.ID, version, domain.Closed, pr.Status, nil, []domain.Event{event})
+}
+
+// open переводит PR без ревьюверов в OPEN и назначает ревьюверов; event пишется перед событиями о назначении.
+func (s *Service) open(ctx context.Context, pr *domain.PullRequest, version int64, event domain.Event) (*domain.PullRequest, error) {
+	if err := pr.Transition(domain.Open); err != nil {
+		return nil, err
+	}
+	author, err := s.repoUser.GetByID(ctx, pr.AuthorID)
+	if err != nil {
+		return nil, err
+	}
+	repo, err := s.getRepository(ctx, pr.RepositoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	assignments, assigned, err := s.staff(ctx, pr, repo, author)
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.repoPR.AssignReviewers(ctx, pr, version, append([]domain.Event{event}, assigned...))
+	if err != nil {
+		return nil, err
+	}
+	res.Assignments = assignments
+	return res, nil
+}
+
+// mergeAttempts — сколько раз MergePullRequest без If-Match проверяет политику заново, если PR
+// изменили между проверкой и merge.
+const mergeAttempts = 3
+
+// MergePullRequest мержит PR, если он выполняет политику merge команды автора и репозитория,
+// иначе возвращает MERGE_BLOCKED с невыполненными условиями. С override PR мержится в обход
+// политики, а факт обхода записывается в журнал аудита. Повторный merge ничего не меняет.
+// Политика проверяется по прочитанному снимку PR, поэтому merge закрепляется за его версией. Если
+// клиент не передал version, параллельное изменение PR не возвращается ему как ErrVersionMismatch:
+// политика проверяется заново, а если PR так и не удалось смержить — возвращается ErrConcurrentUpdate.
+func (s *Service) MergePullRequest(ctx context.Context, prID string, version int64, override *domain.MergeOverride) (*domain.PullRequest, error) {
+	ctx, span := tracer.Start(ctx, "PullRequestService.MergePullRequest")
+	defer span.End()
+
+	if override != nil && override.Reason == "" {
+		return nil, domain.ErrOverrideReasonRequired
+	}
+
+	for attempt := 1; ; attempt++ {
+		pr, err := s.checkedMerge(ctx, prID, version, override)
+		if version != 0 || !errors.Is(err, domain.ErrVersionMismatch) {
+			return pr, err
+		}
+		if attempt == mergeAttempts {
+			return nil, domain.ErrConcurrentUpdate
+		}
+	}
+}
+
+// checkedMerge проверяет политику merge по текущему снимку PR и мержит его, если снимок не изменился.
+func (s *Service) checkedMerge(ctx context.Context, prID string, version int64, override *domain.MergeOverride) (*domain.PullRequest, error) {
+	pr, err := s.forUpdate(ctx, prID, version)
+	if err != nil {
+		return nil, err
+	}
 	if pr.Status == domain.Merged {
 		return pr, nil
 	}
+	if !pr.Status.CanTransitionTo(domain.Merged) {
+		return nil, domain.NewTransitionError(pr.Status, domain.Merged)
+	}
 
-	pr.ChangeStatus(domain.Merged)
-	pr, err = s.repoPR.UpdateStatus(ctx, pr.ID, pr.Status)
+	policy, err := s.mergePolicy(ctx, pr)
 	if err != nil {
 		return nil, err
 	}
-	return pr, nil
+	unmet := policy.Unmet(pr)
+
+	var audit []domain.AuditEntry
+	switch {
+	case override != nil:
+		audit = append(audit, domain.AuditEntry{
+			Action:  domain.AuditMergeOverride,
+			Actor:   override.Actor,
+			Reason:  override.Reason,
+			Details: unmet,
+		})
+	case len(unmet) > 0:
+		return nil, domain.NewMergeBlockedError(unmet)
+	}
+
+	return s.merge(ctx, pr, pr.Version, audit)
 }
 
-func (s *Service) ReassignReviewerPullRequest(ctx context.Context, prID string, reviewerID string) (*domain.PullRequest, error) {
+// SyncMergedPullRequest отмечает PR смерженным по событию из внешнего форжа. Политика merge и правила
+// переходов не проверяются: PR уже смержен во форже, и сервис лишь синхронизирует его состояние, поэтому
+// смерженным отмечается и PR, который в сервисе закрыт или остался черновиком.
+func (s *Service) SyncMergedPullRequest(ctx context.Context, prID string) (*domain.PullRequest, error) {
+	ctx, span := tracer.Start(ctx, "PullRequestService.SyncMergedPullRequest")
+	defer span.End()
+
 	pr, err := s.repoPR.GetByID(ctx, prID)
 	if err != nil {
 		return nil, err
 	}
-
 	if pr.Status == domain.Merged {
-		return nil, domain.ErrReassignPRMerged
+		return pr, nil
 	}
+	return s.repoPR.UpdateStatus(ctx, pr.ID, 0, pr.Status, domain.Merged, nil, []domain.Event{domain.NewEvent(domain.EventPRMerged)})
+}
 
-	found := false
-	for _, assignedID := range pr.AssignedReviewers {
-		if assignedID == reviewerID {
-			found = true
-			break
+func (s *Service) merge(ctx context.Context, pr *domain.PullRequest, version int64, audit []domain.AuditEntry) (*domain.PullRequest, error) {
+	from := pr.Status
+	if err := pr.Transition(domain.Merged); err != nil {
+		return nil, err
+	}
+	return s.repoPR.UpdateStatus(ctx, pr.ID, version, from, pr.Status, audit, []domain.Event{domain.NewEvent(domain.EventPRMerged)})
+}
+
+// mergePolicy возвращает более строгую из политик команды автора PR и репозитория PR.
+func (s *Service) mergePolicy(ctx context.Context, pr *domain.PullRequest) (domain.MergePolicy, error) {
+	var policy domain.MergePolicy
+
+	author, err := s.repoUser.GetByID(ctx, pr.AuthorID)
+	switch {
+	case err == nil:
+		if policy, err = s.repoTeam.GetMergePolicy(ctx, author.TeamName); err != nil {
+			return domain.MergePolicy{}, err
 		}
+	case !errors.Is(err, domain.ErrUserNotFound):
+		return domain.MergePolicy{}, err
+	}
+
+	repo, err := s.getRepository(ctx, pr.RepositoryID)
+	if err != nil {
+		return domain.MergePolicy{}, err
 	}
-	if !found {
-		return nil, fmt.Errorf("reviewer %s not found in PR %s", reviewerID, pr.ID)
+	if repo != nil {
+		policy = policy.Strictest(repo.Settings.MergePolicy)
 	}
+	return policy, nil
+}
+
+// GetPullRequestAudit возвращает журнал аудита PR.
+func (s *Service) GetPullRequestAudit(ctx context.Context, prID string) ([]*domain.AuditEntry, error) {
+	ctx, span := tracer.Start(ctx, "PullRequestService.GetPullRequestAudit")
+	defer span.End()
+
+	if _, err := s.repoPR.GetByID(ctx, prID); err != nil {
+		return nil, err
+	}
+	return s.repoPR.GetAudit(ctx, prID)
+}
 
-	user, err := s.repoUser.GetByID(ctx, reviewerID)
+// ReassignReviewerPullRequest заменяет ревьювера reviewerID на другого активного участника пула
+// репозитория PR или, если пула нет либо он исчерпан, команды ревьювера.
+// Возвращает обновлённый PR вместе с user_id нового ревьювера.
+func (s *Service) ReassignReviewerPullRequest(ctx context.Context, prID string, version int64, reviewerID string) (*domain.PullRequest, string, error) {
+	ctx, span := tracer.Start(ctx, "PullRequestService.ReassignReviewerPullRequest")
+	defer span.End()
+
+	return s.reassign(ctx, prID, version, reviewerID, "")
+}
+
+// ReassignReviewerTo передаёт ревью PR от reviewerID пользователю newReviewerID. К новому ревьюверу
+// применяются те же правила, что и при ручном назначении (см. AddReviewer).
+func (s *Service) ReassignReviewerTo(ctx context.Context, prID string, version int64, reviewerID string, newReviewerID string) (*domain.PullRequest, string, error) {
+	ctx, span := tracer.Start(ctx, "PullRequestService.ReassignReviewerTo")
+	defer span.End()
+
+	return s.reassign(ctx, prID, version, reviewerID, newReviewerID)
+}
+
+// reassign заменяет reviewerID на newReviewerID, а если он не задан — на кандидата из replacement.
+func (s *Service) reassign(ctx context.Context, prID string, version int64, reviewerID string, newReviewerID string) (*domain.PullRequest, string, error) {
+	pr, err := s.assignedOpenPR(ctx, prID, version, reviewerID)
+	if err != nil {
+		return nil, "", err
+	}
+	repo, err := s.getRepository(ctx, pr.RepositoryID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if newReviewerID == "" {
+		if newReviewerID, err = s.replacement(ctx, pr, repo, reviewerID); err != nil {
+			return nil, "", err
+		}
+		if newReviewerID == "" {
+			return nil, "", domain.ErrNoCandidate
+		}
+	} else if err = s.checkAssignable(ctx, pr, repo, newReviewerID); err != nil {
+		return nil, "", err
+	}
+
+	event := domain.NewEvent(domain.EventReviewerReassigned)
+	event.ReviewerID = newReviewerID
+	event.OldReviewerID = reviewerID
+
+	newPR, err := s.repoPR.Reassign(ctx, prID, version, reviewerID, newReviewerID, []domain.Event{event})
+	if err != nil {
+		return nil, "", err
+	}
+
+	return newPR, newReviewerID, nil
+}
+
+// DeclineReview снимает ревьювера reviewerID с PR по его собственной просьбе: вместо него назначается
+// доступный кандидат, как при переназначении, а если кандидатов нет — PR остаётся без замены и помечается
+// need_more_reviewers. Причина отказа записывается в журнал аудита. Возвращает обновлённый PR и user_id
+// замены или пустую строку.
+func (s *Service) DeclineReview(ctx context.Context, prID string, version int64, reviewerID string, reason string) (*domain.PullRequest, string, error) {
+	ctx, span := tracer.Start(ctx, "PullRequestService.DeclineReview")
+	defer span.End()
+
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, "", domain.ErrDeclineReasonRequired
+	}
+	if len([]rune(reason)) > domain.MaxAuditReasonLength {
+		return nil, "", domain.NewError(domain.CodeIncorrectData, fmt.Sprintf("reason must be at most %d characters", domain.MaxAuditReasonLength))
+	}
+
+	pr, err := s.assignedOpenPR(ctx, prID, version, reviewerID)
+	if err != nil {
+		return nil, "", err
+	}
+	repo, err := s.getRepository(ctx, pr.RepositoryID)
+	if err != nil {
+		return nil, "", err
+	}
+	replacedBy, events, err := s.withdraw(ctx, pr, repo, reviewerID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var added []string
+	if replacedBy != "" {
+		added = append(added, replacedBy)
+	}
+	audit := []domain.AuditEntry{{Action: domain.AuditReviewDeclined, Actor: reviewerID, Reason: reason}}
+	res, err := s.repoPR.ChangeReviewers(ctx, pr, version, added, []string{reviewerID}, audit, events)
+	if err != nil {
+		return nil, "", err
+	}
+	return res, replacedBy, nil
+}
+
+// withdraw убирает reviewerID из ревьюверов pr и ставит на его место доступного кандидата, а если
+// кандидатов нет — оставляет место пустым. need_more_reviewers и непокрытые навыки пересчитываются.
+// Возвращает user_id замены или пустую строку и события об изменении; в хранилище ничего не пишет.
+func (s *Service) withdraw(ctx context.Context, pr *domain.PullRequest, repo *domain.Repository, reviewerID string) (string, []domain.Event, error) {
+	replacedBy, err := s.replacement(ctx, pr, repo, reviewerID)
+	if err != nil {
+		return "", nil, err
+	}
+
+	pr.AssignedReviewers = slices.DeleteFunc(pr.AssignedReviewers, func(id string) bool { return id == reviewerID })
+	var events []domain.Event
+	if replacedBy != "" {
+		pr.AssignedReviewers = append(pr.AssignedReviewers, replacedBy)
+
+		event := domain.NewEvent(domain.EventReviewerReassigned)
+		event.ReviewerID = replacedBy
+		event.OldReviewerID = reviewerID
+		events = append(events, event)
+	} else {
+		event := domain.NewEvent(domain.EventReviewerRemoved)
+		event.ReviewerID = reviewerID
+		events = append(events, event)
+	}
+
+	understaffed := pr.NeedMoreReviewers
+	if err = s.recountStaffing(ctx, pr, repo); err != nil {
+		return "", nil, err
+	}
+	if pr.NeedMoreReviewers && !understaffed {
+		events = append(events, domain.NewEvent(domain.EventPRUnderstaffed))
+	}
+	return replacedBy, events, nil
+}
+
+// UpdatePullRequest меняет название, автора, описание, метки и приоритет PR. Правила ревью команды
+// применяются только при назначении ревьюверов, поэтому уже назначенные ревьюверы и срок ревью не меняются.
+// Автора можно сменить только у черновика или открытого PR; если новый автор был ревьювером,
+// он снимается с ревью и заменяется так же, как при отказе от ревью.
+func (s *Service) UpdatePullRequest(ctx context.Context, prID string, version int64, update *domain.PullRequestUpdate) (*domain.PullRequest, error) {
+	ctx, span := tracer.Start(ctx, "PullRequestService.UpdatePullRequest")
+	defer span.End()
+
+	pr, err := s.forUpdate(ctx, prID, version)
+	if err != nil {
+		return nil, err
+	}
+	prevAuthorID := pr.AuthorID
+	if err = update.Apply(pr); err != nil {
+		return nil, err
+	}
+
+	var added, removed []string
+	events := []domain.Event{domain.NewEvent(domain.EventPRUpdated)}
+	if pr.AuthorID != prevAuthorID {
+		if err = pr.Status.CheckStaffable(); err != nil {
+			return nil, err
+		}
+		if _, err = s.repoUser.GetByID(ctx, pr.AuthorID); err != nil {
+			return nil, err
+		}
+		if slices.Contains(pr.AssignedReviewers, pr.AuthorID) {
+			repo, err := s.getRepository(ctx, pr.RepositoryID)
+			if err != nil {
+				return nil, err
+			}
+			replacedBy, staffed, err := s.withdraw(ctx, pr, repo, pr.AuthorID)
+			if err != nil {
+				return nil, err
+			}
+			removed = append(removed, pr.AuthorID)
+			if replacedBy != "" {
+				added = append(added, replacedBy)
+			}
+			events = append(events, staffed...)
+		}
+	}
+
+	return s.repoPR.UpdateMetadata(ctx, pr, version, added, removed, events)
+}
+
+// forUpdate возвращает PR, который собираются изменить. Если ожидаемая версия PR version задана
+// и уже устарела, сразу возвращается ErrVersionMismatch; окончательно версия проверяется хранилищем
+// в транзакции изменения.
+func (s *Service) forUpdate(ctx context.Context, prID string, version int64) (*domain.PullRequest, error) {
+	pr, err := s.repoPR.GetByID(ctx, prID)
 	if err != nil {
 		return nil, err
 	}
-	excludeUsers := []string{pr.AuthorID, user.UserID}
-	newUser, err := s.repoUser.GetInactiveUsers(ctx, user.TeamName, 1, excludeUsers)
+	if err = domain.CheckVersion(version, pr.Version); err != nil {
+		return nil, err
+	}
+	return pr, nil
+}
+
+// assignedOpenPR возвращает открытый PR, ревьювером которого назначен reviewerID.
+func (s *Service) assignedOpenPR(ctx context.Context, prID string, version int64, reviewerID string) (*domain.PullRequest, error) {
+	pr, err := s.forUpdate(ctx, prID, version)
 	if err != nil {
 		return nil, err
 	}
-	if len(newUser) < 1 {
-		return nil, fmt.Errorf("no inactive user")
+	if pr.Status == domain.Merged {
+		return nil, domain.ErrReassignPRMerged
+	}
+	if pr.Status != domain.Open {
+		return nil, domain.ErrPRNotOpen
+	}
+	if !slices.Contains(pr.AssignedReviewers, reviewerID) {
+		return nil, fmt.Errorf("reviewer %s in PR %s: %w", reviewerID, pr.ID, domain.ErrNotAssigned)
 	}
-	u := newUser[0]
+	return pr, nil
+}
 
-	user.ChangeActive(true)
-	u.ChangeActive(false)
+// AddReviewer вручную назначает пользователя reviewerID ревьювером открытого PR. Пользователь должен
+// быть активен, не в отсутствии, иметь запас по лимиту открытых ревью и входить в пул репозитория PR
+// или команду автора; всего у PR может быть не больше domain.MaxReviewersCount ревьюверов.
+func (s *Service) AddReviewer(ctx context.Context, prID string, version int64, reviewerID string) (*domain.PullRequest, error) {
+	ctx, span := tracer.Start(ctx, "PullRequestService.AddReviewer")
+	defer span.End()
 
-	newPR, err := s.repoPR.Reassign(ctx, prID, user.UserID, u.UserID)
+	pr, err := s.forUpdate(ctx, prID, version)
 	if err != nil {
 		return nil, err
 	}
-	_, err = s.repoUser.UpdateIsActive(ctx, user.UserID, user.IsActive)
+	if pr.Status != domain.Open {
+		return nil, domain.ErrPRNotOpen
+	}
+	if len(pr.AssignedReviewers) >= domain.MaxReviewersCount {
+		return nil, domain.ErrReviewersLimit
+	}
+
+	repo, err := s.getRepository(ctx, pr.RepositoryID)
 	if err != nil {
 		return nil, err
 	}
-	_, err = s.repoUser.UpdateIsActive(ctx, u.UserID, u.IsActive)
+	if err = s.checkAssignable(ctx, pr, repo, reviewerID); err != nil {
+		return nil, err
+	}
+
+	pr.AssignedReviewers = append(pr.AssignedReviewers, reviewerID)
+	if err = s.recountStaffing(ctx, pr, repo); err != nil {
+		return nil, err
+	}
+
+	event := domain.NewEvent(domain.EventReviewerAssigned)
+	event.ReviewerID = reviewerID
+	return s.repoPR.ChangeReviewers(ctx, pr, version, []string{reviewerID}, nil, nil, []domain.Event{event})
+}
+
+// RemoveReviewer снимает ревьювера reviewerID с открытого PR. С backfill вместо него назначается
+// доступный кандидат из пула репозитория или команды снятого ревьювера, как при замене;
+// если кандидата нет, PR помечается need_more_reviewers.
+// Возвращает обновлённый PR и user_id назначенной замены или пустую строку.
+func (s *Service) RemoveReviewer(ctx context.Context, prID string, version int64, reviewerID string, backfill bool) (*domain.PullRequest, string, error) {
+	ctx, span := tracer.Start(ctx, "PullRequestService.RemoveReviewer")
+	defer span.End()
+
+	pr, err := s.forUpdate(ctx, prID, version)
+	if err != nil {
+		return nil, "", err
+	}
+	if pr.Status != domain.Open {
+		return nil, "", domain.ErrPRNotOpen
+	}
+	if !slices.Contains(pr.AssignedReviewers, reviewerID) {
+		return nil, "", fmt.Errorf("reviewer %s in PR %s: %w", reviewerID, pr.ID, domain.ErrNotAssigned)
+	}
+	repo, err := s.getRepository(ctx, pr.RepositoryID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// Замена подбирается, пока reviewerID ещё среди назначенных, чтобы он не был выбран снова.
+	var added []string
+	if backfill {
+		userID, err := s.replacement(ctx, pr, repo, reviewerID)
+		if err != nil {
+			return nil, "", err
+		}
+		if userID != "" {
+			added = append(added, userID)
+		}
+	}
+
+	pr.AssignedReviewers = slices.DeleteFunc(pr.AssignedReviewers, func(id string) bool { return id == reviewerID })
+	removed := domain.NewEvent(domain.EventReviewerRemoved)
+	removed.ReviewerID = reviewerID
+	events := []domain.Event{removed}
+	for _, userID := range added {
+		pr.AssignedReviewers = append(pr.AssignedReviewers, userID)
+
+		assigned := domain.NewEvent(domain.EventReviewerAssigned)
+		assigned.ReviewerID = userID
+		events = append(events, assigned)
+	}
+
+	understaffed := pr.NeedMoreReviewers
+	if err = s.recountStaffing(ctx, pr, repo); err != nil {
+		return nil, "", err
+	}
+	if pr.NeedMoreReviewers && !understaffed {
+		events = append(events, domain.NewEvent(domain.EventPRUnderstaffed))
+	}
+
+	res, err := s.repoPR.ChangeReviewers(ctx, pr, version, added, []string{reviewerID}, nil, events)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(added) == 0 {
+		return res, "", nil
+	}
+	return res, added[0], nil
+}
+
+// SubmitReview записывает вердикт назначенного ревьювера по открытому PR. Повторный вердикт
+// заменяет предыдущий.
+func (s *Service) SubmitReview(ctx context.Context, prID string, version int64, reviewerID string, verdict domain.Verdict, comment string) (*domain.PullRequest, error) {
+	ctx, span := tracer.Start(ctx, "PullRequestService.SubmitReview")
+	defer span.End()
+
+	if err := verdict.Validate(); err != nil {
+		return nil, err
+	}
+	if len([]rune(comment)) > domain.MaxReviewCommentLength {
+		return nil, domain.NewError(domain.CodeIncorrectData, fmt.Sprintf("comment must be at most %d characters", domain.MaxReviewCommentLength))
+	}
+
+	pr, err := s.forUpdate(ctx, prID, version)
 	if err != nil {
 		return nil, err
 	}
+	if pr.Status == domain.Merged {
+		return nil, domain.ErrReviewPRMerged
+	}
+	if pr.Status != domain.Open {
+		return nil, domain.ErrPRNotOpen
+	}
+	if !slices.Contains(pr.AssignedReviewers, reviewerID) {
+		return nil, fmt.Errorf("reviewer %s in PR %s: %w", reviewerID, pr.ID, domain.ErrNotAssigned)
+	}
+
+	event := domain.NewEvent(domain.EventReviewSubmitted)
+	event.ReviewerID = reviewerID
+	event.Verdict = verdict
 
-	return newPR, nil
+	return s.repoPR.SubmitReview(ctx, prID, version, &domain.Review{
+		ReviewerID: reviewerID,
+		Verdict:    verdict,
+		Comment:    comment,
+	}, []domain.Event{event})
 }
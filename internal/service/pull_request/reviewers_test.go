@@ -0,0 +1,421 @@
+package pull_request
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/LeoUraltsev/PRReviewerService/internal/clock"
+	"github.com/LeoUraltsev/PRReviewerService/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryPRs хранит PR в памяти и запоминает события последнего изменения.
+type memoryPRs struct {
+	prs    map[string]*domain.PullRequest
+	events []domain.Event
+}
+
+func (m *memoryPRs) Save(_ context.Context, pr *domain.PullRequest, events []domain.Event) error {
+	m.prs[pr.ID] = pr
+	m.events = events
+	return nil
+}
+
+func (m *memoryPRs) GetByID(_ context.Context, prID string) (*domain.PullRequest, error) {
+	pr, ok := m.prs[prID]
+	if !ok {
+		return nil, domain.ErrPRNotFound
+	}
+	res := *pr
+	res.AssignedReviewers = slices.Clone(pr.AssignedReviewers)
+	res.Reviews = slices.Clone(pr.Reviews)
+	return &res, nil
+}
+
+func (m *memoryPRs) UpdateStatus(ctx context.Context, id string, version int64, from domain.Status, status domain.Status, _ []domain.AuditEntry, events []domain.Event) (*domain.PullRequest, error) {
+	if err := domain.CheckVersion(version, m.prs[id].Version); err != nil {
+		return nil, err
+	}
+	if m.prs[id].Status != from {
+		return nil, domain.NewTransitionError(m.prs[id].Status, status)
+	}
+	m.prs[id].Status = status
+	m.prs[id].Version++
+	m.events = events
+	return m.GetByID(ctx, id)
+}
+
+func (m *memoryPRs) Reassign(ctx context.Context, prID string, _ int64, oldUserID string, newUserID string, events []domain.Event) (*domain.PullRequest, error) {
+	pr := m.prs[prID]
+	pr.AssignedReviewers[slices.Index(pr.AssignedReviewers, oldUserID)] = newUserID
+	m.events = events
+	return m.GetByID(ctx, prID)
+}
+
+func (m *memoryPRs) SubmitReview(ctx context.Context, prID string, _ int64, review *domain.Review, events []domain.Event) (*domain.PullRequest, error) {
+	pr := m.prs[prID]
+	pr.Reviews = slices.DeleteFunc(pr.Reviews, func(r domain.Review) bool { return r.ReviewerID == review.ReviewerID })
+	pr.Reviews = append(pr.Reviews, *review)
+	m.events = events
+	return m.GetByID(ctx, prID)
+}
+
+func (m *memoryPRs) GetAudit(context.Context, string) ([]*domain.AuditEntry, error) {
+	return nil, nil
+}
+
+func (m *memoryPRs) AssignReviewers(ctx context.Context, pr *domain.PullRequest, _ int64, events []domain.Event) (*domain.PullRequest, error) {
+	return m.replace(ctx, pr, events)
+}
+
+func (m *memoryPRs) ChangeReviewers(ctx context.Context, pr *domain.PullRequest, _ int64, _ []string, _ []string, _ []domain.AuditEntry, events []domain.Event) (*domain.PullRequest, error) {
+	return m.replace(ctx, pr, events)
+}
+
+func (m *memoryPRs) UpdateMetadata(ctx context.Context, pr *domain.PullRequest, version int64, _ []string, _ []string, events []domain.Event) (*domain.PullRequest, error) {
+	if err := domain.CheckVersion(version, m.prs[pr.ID].Version); err != nil {
+		return nil, err
+	}
+	pr.Version = m.prs[pr.ID].Version + 1
+	return m.replace(ctx, pr, events)
+}
+
+func (m *memoryPRs) replace(ctx context.Context, pr *domain.PullRequest, events []domain.Event) (*domain.PullRequest, error) {
+	saved := *pr
+	m.prs[pr.ID] = &saved
+	m.events = events
+	return m.GetByID(ctx, pr.ID)
+}
+
+func eventTypes(events []domain.Event) []domain.EventType {
+	res := make([]domain.EventType, len(events))
+	for i, e := range events {
+		res[i] = e.Type
+	}
+	return res
+}
+
+func newReviewersFixture() (*Service, *memoryPRs, *memoryStore) {
+	store := &memoryStore{
+		users: []*domain.User{
+			{UserID: "u1", TeamName: "backend", IsActive: true},
+			{UserID: "u2", TeamName: "backend", IsActive: true},
+			{UserID: "u3", TeamName: "backend", IsActive: true},
+			{UserID: "u4", TeamName: "backend", IsActive: true},
+			{UserID: "u5", TeamName: "backend", IsActive: false},
+			{UserID: "u6", TeamName: "payments", IsActive: true},
+			{UserID: "u7", TeamName: "backend", IsActive: true},
+		},
+		away: []string{"u7"},
+	}
+	// u4 ревьюит три открытых PR и упирается в лимит; закрытый pr-2 нагрузку u2 не увеличивает.
+	prs := &memoryPRs{prs: map[string]*domain.PullRequest{
+		"pr-1":     {ID: "pr-1", AuthorID: "u1", Status: domain.Open, AssignedReviewers: []string{"u2"}, NeedMoreReviewers: true},
+		"pr-2":     {ID: "pr-2", AuthorID: "u1", Status: domain.Closed, AssignedReviewers: []string{"u2"}},
+		"pr-load1": {ID: "pr-load1", AuthorID: "u6", Status: domain.Open, AssignedReviewers: []string{"u4"}},
+		"pr-load2": {ID: "pr-load2", AuthorID: "u6", Status: domain.Open, AssignedReviewers: []string{"u4"}},
+		"pr-load3": {ID: "pr-load3", AuthorID: "u6", Status: domain.Open, AssignedReviewers: []string{"u4"}},
+	}}
+	store.prs = prs
+	return NewService(prs, store, store, store, clock.NewFake(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))), prs, store
+}
+
+func TestService_AddReviewer(t *testing.T) {
+	tests := []struct {
+		name       string
+		prID       string
+		reviewerID string
+		wantErr    error
+	}{
+		{"closed pr", "pr-2", "u3", domain.ErrPRNotOpen},
+		{"already assigned", "pr-1", "u2", domain.ErrAlreadyAssigned},
+		{"author", "pr-1", "u1", domain.ErrReviewerIsAuthor},
+		{"unknown user", "pr-1", "u9", domain.ErrUserNotFound},
+		{"inactive", "pr-1", "u5", domain.ErrReviewerInactive},
+		{"other team", "pr-1", "u6", domain.ErrReviewerOutsidePool},
+		{"out of office", "pr-1", "u7", domain.ErrReviewerOutOfOffice},
+		{"at capacity", "pr-1", "u4", domain.ErrReviewerAtCapacity},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _, _ := newReviewersFixture()
+			_, err := s.AddReviewer(context.Background(), tt.prID, 0, tt.reviewerID)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+
+	s, prs, _ := newReviewersFixture()
+	_, err := s.AddReviewer(context.Background(), "pr-1", 5, "u3")
+	assert.ErrorIs(t, err, domain.ErrVersionMismatch)
+
+	pr, err := s.AddReviewer(context.Background(), "pr-1", 0, "u3")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"u2", "u3"}, pr.AssignedReviewers)
+	assert.False(t, pr.NeedMoreReviewers)
+	require.Len(t, prs.events, 1)
+	assert.Equal(t, domain.EventReviewerAssigned, prs.events[0].Type)
+	assert.Equal(t, "u3", prs.events[0].ReviewerID)
+}
+
+func TestService_RemoveReviewer(t *testing.T) {
+	ctx := context.Background()
+
+	s, prs, _ := newReviewersFixture()
+	_, _, err := s.RemoveReviewer(ctx, "pr-1", 0, "u3", false)
+	assert.ErrorIs(t, err, domain.ErrNotAssigned)
+
+	// Кандидаты: u3 свободен, u4 на пределе лимита, u5 неактивен, u7 в отсутствии.
+	pr, backfilledBy, err := s.RemoveReviewer(ctx, "pr-1", 0, "u2", true)
+	require.NoError(t, err)
+	assert.Equal(t, "u3", backfilledBy)
+	assert.Equal(t, []string{"u3"}, pr.AssignedReviewers)
+	assert.Equal(t, []domain.EventType{domain.EventReviewerRemoved, domain.EventReviewerAssigned}, eventTypes(prs.events))
+
+	pr, backfilledBy, err = s.RemoveReviewer(ctx, "pr-1", 0, "u3", false)
+	require.NoError(t, err)
+	assert.Empty(t, backfilledBy)
+	assert.Empty(t, pr.AssignedReviewers)
+	assert.True(t, pr.NeedMoreReviewers)
+	assert.Equal(t, []domain.EventType{domain.EventReviewerRemoved}, eventTypes(prs.events))
+
+	// Замена выбирается из команды снятого ревьювера, а не автора.
+	prs.prs["pr-3"] = &domain.PullRequest{ID: "pr-3", AuthorID: "u6", Status: domain.Open, AssignedReviewers: []string{"u2"}}
+	pr, backfilledBy, err = s.RemoveReviewer(ctx, "pr-3", 0, "u2", true)
+	require.NoError(t, err)
+	assert.Equal(t, "u1", backfilledBy)
+	assert.Equal(t, []string{"u1"}, pr.AssignedReviewers)
+}
+
+func TestService_ReassignReviewerTo(t *testing.T) {
+	ctx := context.Background()
+	s, prs, _ := newReviewersFixture()
+
+	_, _, err := s.ReassignReviewerTo(ctx, "pr-1", 0, "u2", "u7")
+	assert.ErrorIs(t, err, domain.ErrReviewerOutOfOffice)
+	_, _, err = s.ReassignReviewerTo(ctx, "pr-1", 0, "u2", "u1")
+	assert.ErrorIs(t, err, domain.ErrReviewerIsAuthor)
+
+	pr, replacedBy, err := s.ReassignReviewerTo(ctx, "pr-1", 0, "u2", "u3")
+	require.NoError(t, err)
+	assert.Equal(t, "u3", replacedBy)
+	assert.Equal(t, []string{"u3"}, pr.AssignedReviewers)
+	require.Len(t, prs.events, 1)
+	assert.Equal(t, "u2", prs.events[0].OldReviewerID)
+	assert.Equal(t, "u3", prs.events[0].ReviewerID)
+}
+
+func TestService_DeclineReview(t *testing.T) {
+	ctx := context.Background()
+	s, prs, store := newReviewersFixture()
+
+	_, _, err := s.DeclineReview(ctx, "pr-1", 0, "u2", "  ")
+	assert.ErrorIs(t, err, domain.ErrDeclineReasonRequired)
+
+	pr, replacedBy, err := s.DeclineReview(ctx, "pr-1", 0, "u2", "on call this week")
+	require.NoError(t, err)
+	assert.Equal(t, "u3", replacedBy)
+	assert.Equal(t, []string{"u3"}, pr.AssignedReviewers)
+	assert.Equal(t, []domain.EventType{domain.EventReviewerReassigned}, eventTypes(prs.events))
+
+	// Больше некому передать ревью: u3 снимается без замены.
+	store.away = append(store.away, "u2", "u4")
+	pr, replacedBy, err = s.DeclineReview(ctx, "pr-1", 0, "u3", "conflict of interest")
+	require.NoError(t, err)
+	assert.Empty(t, replacedBy)
+	assert.Empty(t, pr.AssignedReviewers)
+	assert.True(t, pr.NeedMoreReviewers)
+	assert.Equal(t, []domain.EventType{domain.EventReviewerRemoved}, eventTypes(prs.events))
+}
+
+func TestService_UpdatePullRequest(t *testing.T) {
+	ctx := context.Background()
+	s, prs, _ := newReviewersFixture()
+	prs.prs["pr-1"].Version = 1
+
+	_, err := s.UpdatePullRequest(ctx, "pr-1", 2, &domain.PullRequestUpdate{Name: ptr("Rename")})
+	assert.ErrorIs(t, err, domain.ErrVersionMismatch)
+	_, err = s.UpdatePullRequest(ctx, "pr-2", 0, &domain.PullRequestUpdate{AuthorID: ptr("u3")})
+	assert.ErrorIs(t, err, domain.ErrPRNotOpen)
+
+	// Новый автор был ревьювером PR: вместо него назначается доступный кандидат, в том числе прежний автор.
+	pr, err := s.UpdatePullRequest(ctx, "pr-1", 1, &domain.PullRequestUpdate{
+		Name:     ptr("Rename"),
+		AuthorID: ptr("u2"),
+		Labels:   &[]string{"Hotfix", "hotfix", " security"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Rename", pr.Name)
+	assert.Equal(t, "u2", pr.AuthorID)
+	assert.Equal(t, []string{"hotfix", "security"}, pr.Labels)
+	assert.Equal(t, []string{"u1"}, pr.AssignedReviewers)
+	assert.Equal(t, int64(2), pr.Version)
+	assert.Equal(t, []domain.EventType{domain.EventPRUpdated, domain.EventReviewerReassigned}, eventTypes(prs.events))
+}
+
+func TestService_SavePullRequest_ReviewRules(t *testing.T) {
+	ctx := context.Background()
+	s, prs, store := newReviewersFixture()
+	store.skills = map[string][]string{"u3": {"senior"}}
+	store.rules = map[string][]domain.ReviewRule{"backend": {
+		{Label: "hotfix", RequiredSkills: []string{"senior"}, SLA: 4 * time.Hour},
+		{Priority: domain.PriorityUrgent, SLA: time.Hour},
+	}}
+
+	_, err := s.SavePullRequest(ctx, &domain.PullRequest{ID: "pr-3", AuthorID: "u1", Priority: "ASAP"})
+	var domainErr *domain.Error
+	require.ErrorAs(t, err, &domainErr)
+	assert.Equal(t, domain.CodeIncorrectData, domainErr.Code)
+
+	pr, err := s.SavePullRequest(ctx, &domain.PullRequest{ID: "pr-3", AuthorID: "u1"})
+	require.NoError(t, err)
+	assert.Equal(t, domain.PriorityNormal, pr.Priority)
+	assert.Empty(t, pr.RequiredSkills)
+	assert.Nil(t, pr.ReviewDueAt)
+
+	// Хотфикс требует ревьювера с навыком senior, а срочный приоритет сокращает срок ревью до часа.
+	// XL нужны три ревьювера: закрытие PR, которые ревьюит u4, освобождает ему место.
+	for _, id := range []string{"pr-load1", "pr-load2", "pr-load3"} {
+		prs.prs[id].Status = domain.Closed
+	}
+	pr, err = s.SavePullRequest(ctx, &domain.PullRequest{
+		ID:       "pr-4",
+		AuthorID: "u1",
+		Labels:   []string{"HotFix"},
+		Priority: domain.PriorityUrgent,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"hotfix"}, pr.Labels)
+	assert.Equal(t, []string{"senior"}, pr.RequiredSkills)
+	assert.Contains(t, pr.AssignedReviewers, "u3")
+	assert.Empty(t, pr.MissingSkills)
+	assert.Equal(t, ptr(time.Date(2026, 1, 1, 13, 0, 0, 0, time.UTC)), prs.prs["pr-4"].ReviewDueAt)
+}
+
+func TestService_SavePullRequest_Size(t *testing.T) {
+	ctx := context.Background()
+	s, prs, store := newReviewersFixture()
+	store.rules = map[string][]domain.ReviewRule{"backend": {
+		{Size: domain.SizeXS, ReviewersCount: 1},
+		{Size: domain.SizeXL, ReviewersCount: 3, SLA: 48 * time.Hour},
+	}}
+
+	_, err := s.SavePullRequest(ctx, &domain.PullRequest{ID: "pr-3", AuthorID: "u1", Size: &domain.PullRequestSize{LinesAdded: -1}})
+	assert.Error(t, err)
+
+	pr, err := s.SavePullRequest(ctx, &domain.PullRequest{
+		ID:       "pr-3",
+		AuthorID: "u1",
+		Size:     &domain.PullRequestSize{LinesAdded: 1, LinesRemoved: 1, FilesChanged: 1},
+	})
+	require.NoError(t, err)
+	assert.Len(t, pr.AssignedReviewers, 1)
+	assert.False(t, pr.NeedMoreReviewers)
+	assert.Nil(t, pr.ReviewDueAt)
+
+	// XL нужны три ревьювера: закрытие PR, которые ревьюит u4, освобождает ему место.
+	for _, id := range []string{"pr-load1", "pr-load2", "pr-load3"} {
+		prs.prs[id].Status = domain.Closed
+	}
+	pr, err = s.SavePullRequest(ctx, &domain.PullRequest{
+		ID:       "pr-4",
+		AuthorID: "u1",
+		Size:     &domain.PullRequestSize{LinesAdded: 2800, LinesRemoved: 200, FilesChanged: 60},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, domain.SizeXL, pr.SizeBucket())
+	assert.Equal(t, 3, pr.ReviewersCount)
+	assert.Len(t, pr.AssignedReviewers, 3)
+	assert.Equal(t, ptr(time.Date(2026, 1, 3, 12, 0, 0, 0, time.UTC)), pr.ReviewDueAt)
+
+	// Без объёма изменений размер неизвестен, и число ревьюверов берётся из настроек по умолчанию.
+	pr, err = s.SavePullRequest(ctx, &domain.PullRequest{ID: "pr-5", AuthorID: "u1"})
+	require.NoError(t, err)
+	assert.Len(t, pr.AssignedReviewers, domain.DefaultReviewersCount)
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+// racingPRs имитирует изменение PR параллельным запросом сразу после того, как сервис его прочитал;
+// races — сколько раз это произойдёт.
+type racingPRs struct {
+	*memoryPRs
+	races int
+}
+
+func (r *racingPRs) GetByID(ctx context.Context, prID string) (*domain.PullRequest, error) {
+	pr, err := r.memoryPRs.GetByID(ctx, prID)
+	if err == nil && r.races > 0 {
+		r.races--
+		r.prs[prID].Version++
+	}
+	return pr, err
+}
+
+func TestService_MergePullRequest_PinsReadVersion(t *testing.T) {
+	now := clock.NewFake(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+	_, prs, store := newReviewersFixture()
+	prs.prs["pr-1"].Version = 1
+
+	// С If-Match параллельное изменение возвращается клиенту как ErrVersionMismatch.
+	s := NewService(&racingPRs{memoryPRs: prs, races: 1}, store, store, store, now)
+	_, err := s.MergePullRequest(context.Background(), "pr-1", 1, nil)
+	require.ErrorIs(t, err, domain.ErrVersionMismatch)
+
+	// Без If-Match политика проверяется заново, а не применяется к изменённому PR.
+	s = NewService(&racingPRs{memoryPRs: prs, races: mergeAttempts}, store, store, store, now)
+	_, err = s.MergePullRequest(context.Background(), "pr-1", 0, nil)
+	require.ErrorIs(t, err, domain.ErrConcurrentUpdate)
+	assert.Equal(t, domain.Open, prs.prs["pr-1"].Status)
+
+	s = NewService(&racingPRs{memoryPRs: prs, races: 1}, store, store, store, now)
+	pr, err := s.MergePullRequest(context.Background(), "pr-1", 0, nil)
+	require.NoError(t, err)
+	assert.Equal(t, domain.Merged, pr.Status)
+}
+
+func TestService_SyncMergedPullRequest(t *testing.T) {
+	s, prs, _ := newReviewersFixture()
+	prs.prs["pr-3"] = &domain.PullRequest{ID: "pr-3", AuthorID: "u1", Status: domain.Draft}
+
+	// PR уже смержен во форже, поэтому смерженными отмечаются и закрытый PR, и черновик.
+	for _, prID := range []string{"pr-1", "pr-2", "pr-3"} {
+		pr, err := s.SyncMergedPullRequest(context.Background(), prID)
+		require.NoError(t, err, prID)
+		assert.Equal(t, domain.Merged, pr.Status, prID)
+	}
+	assert.Equal(t, []domain.EventType{domain.EventPRMerged}, eventTypes(prs.events))
+
+	// Повторное событие ничего не меняет.
+	pr, err := s.SyncMergedPullRequest(context.Background(), "pr-2")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), pr.Version)
+}
+
+func TestService_SubmitReview(t *testing.T) {
+	s, prs, _ := newReviewersFixture()
+	ctx := context.Background()
+
+	_, err := s.SubmitReview(ctx, "pr-1", 0, "u3", domain.VerdictApproved, "")
+	require.ErrorIs(t, err, domain.ErrNotAssigned)
+	_, err = s.SubmitReview(ctx, "pr-2", 0, "u2", domain.VerdictApproved, "")
+	require.ErrorIs(t, err, domain.ErrPRNotOpen)
+
+	pr, err := s.SubmitReview(ctx, "pr-1", 0, "u2", domain.VerdictChangesRequested, "needs tests")
+	require.NoError(t, err)
+	require.Len(t, pr.Reviews, 1)
+	assert.Equal(t, domain.VerdictChangesRequested, pr.Reviews[0].Verdict)
+	require.Len(t, prs.events, 1)
+	assert.Equal(t, domain.VerdictChangesRequested, prs.events[0].Verdict)
+
+	// Повторный вердикт заменяет предыдущий, а не добавляется к нему.
+	pr, err = s.SubmitReview(ctx, "pr-1", 0, "u2", domain.VerdictApproved, "")
+	require.NoError(t, err)
+	require.Len(t, pr.Reviews, 1)
+	assert.Equal(t, domain.VerdictApproved, pr.Reviews[0].Verdict)
+	assert.Equal(t, domain.VerdictApproved, prs.events[0].Verdict)
+}
@@ -0,0 +1,464 @@
+package pull_request
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+
+	"github.com/LeoUraltsev/PRReviewerService/internal/domain"
+)
+
+// candidate — доступный пользователь и источник, из которого он выбран.
+type candidate struct {
+	user   *domain.User
+	source domain.AssignmentSource
+}
+
+// getRepository возвращает репозиторий PR или nil, если PR не привязан к репозиторию.
+func (s *Service) getRepository(ctx context.Context, repositoryID string) (*domain.Repository, error) {
+	if repositoryID == "" {
+		return nil, nil
+	}
+	return s.repoRepository.GetRepository(ctx, repositoryID)
+}
+
+// unavailableUsers возвращает users вместе с пользователями, которые сейчас в отпуске или
+// иначе отсутствуют; такие пользователи не назначаются ревьюверами.
+func (s *Service) unavailableUsers(ctx context.Context, users ...string) ([]string, error) {
+	outOfOffice, err := s.repoUser.GetOutOfOfficeUserIDs(ctx, s.clock.Now())
+	if err != nil {
+		return nil, err
+	}
+	return append(users, outOfOffice...), nil
+}
+
+// candidatesLookahead — сколько доступных пользователей запрашивается как минимум, чтобы среди
+// них было из кого выбрать тех, у кого сейчас рабочее время.
+const candidatesLookahead = 20
+
+// byWorkingHours упорядочивает users так, что сначала идут те, у кого сейчас рабочее время
+// (и те, у кого расписание не задано), затем остальные; пользователи вне рабочего времени из
+// команд с политикой require отбрасываются. Внутри групп порядок сохраняется. Возвращает
+// не больше limit пользователей.
+func (s *Service) byWorkingHours(ctx context.Context, users []*domain.User, limit int) ([]*domain.User, error) {
+	if len(users) == 0 {
+		return users, nil
+	}
+	userIDs := make([]string, len(users))
+	for i, u := range users {
+		userIDs[i] = u.UserID
+	}
+	hours, err := s.repoUser.GetWorkingHours(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.clock.Now()
+	res := make([]*domain.User, 0, len(users))
+	var offHours []*domain.User
+	for _, u := range users {
+		h, ok := hours[u.UserID]
+		switch {
+		case !ok || h.Within(now):
+			res = append(res, u)
+		case h.Policy == domain.WorkingHoursRequire:
+		default:
+			offHours = append(offHours, u)
+		}
+	}
+	res = append(res, offHours...)
+	if len(res) > limit {
+		res = res[:limit]
+	}
+	return res, nil
+}
+
+// replacement подбирает доступного кандидата на место reviewerID из пула репозитория PR или команды
+// ревьювера. Пустая строка — кандидатов нет.
+func (s *Service) replacement(ctx context.Context, pr *domain.PullRequest, repo *domain.Repository, reviewerID string) (string, error) {
+	reviewer, err := s.repoUser.GetByID(ctx, reviewerID)
+	if err != nil {
+		return "", err
+	}
+	excludeUsers, err := s.unavailableUsers(ctx, append([]string{pr.AuthorID}, pr.AssignedReviewers...)...)
+	if err != nil {
+		return "", err
+	}
+	candidates, err := s.candidates(ctx, repo, reviewer.TeamName, 1, excludeUsers)
+	if err != nil || len(candidates) == 0 {
+		return "", err
+	}
+	return candidates[0].user.UserID, nil
+}
+
+// checkAssignable проверяет, можно ли вручную назначить пользователя reviewerID ревьювером PR
+// в дополнение к текущим или вместо одного из них.
+func (s *Service) checkAssignable(ctx context.Context, pr *domain.PullRequest, repo *domain.Repository, reviewerID string) error {
+	switch {
+	case slices.Contains(pr.AssignedReviewers, reviewerID):
+		return domain.ErrAlreadyAssigned
+	case reviewerID == pr.AuthorID:
+		return domain.ErrReviewerIsAuthor
+	}
+	return s.checkEligible(ctx, pr, repo, reviewerID)
+}
+
+// checkEligible проверяет, может ли пользователь reviewerID быть ревьювером PR.
+func (s *Service) checkEligible(ctx context.Context, pr *domain.PullRequest, repo *domain.Repository, reviewerID string) error {
+	reviewer, err := s.repoUser.GetByID(ctx, reviewerID)
+	if err != nil {
+		return err
+	}
+	if !reviewer.IsActive {
+		return domain.ErrReviewerInactive
+	}
+
+	author, err := s.repoUser.GetByID(ctx, pr.AuthorID)
+	if err != nil {
+		return err
+	}
+	if !inPool(repo, author, reviewer) {
+		return domain.ErrReviewerOutsidePool
+	}
+
+	away, err := s.unavailableUsers(ctx)
+	if err != nil {
+		return err
+	}
+	if slices.Contains(away, reviewerID) {
+		return domain.ErrReviewerOutOfOffice
+	}
+
+	capacity, err := s.repoUser.GetCapacity(ctx, reviewerID)
+	if err != nil {
+		return err
+	}
+	if capacity.Remaining() == 0 {
+		return domain.ErrReviewerAtCapacity
+	}
+	return nil
+}
+
+// inPool сообщает, входит ли user в число тех, из кого выбираются ревьюверы PR автора author:
+// в пул репозитория или, если пула нет либо настройки разрешают её добирать, в команду автора.
+func inPool(repo *domain.Repository, author *domain.User, user *domain.User) bool {
+	if repo != nil && repo.HasPool() {
+		if slices.Contains(repo.OwnerTeams, user.TeamName) || slices.Contains(repo.Reviewers, user.UserID) {
+			return true
+		}
+		if !repo.Settings.AuthorTeamFallback {
+			return false
+		}
+	}
+	return user.TeamName == author.TeamName
+}
+
+// recountStaffing пересчитывает need_more_reviewers и непокрытые навыки PR по текущему составу ревьюверов.
+func (s *Service) recountStaffing(ctx context.Context, pr *domain.PullRequest, repo *domain.Repository) error {
+	pr.NeedMoreReviewers = len(pr.AssignedReviewers) < reviewersCount(pr, repo)
+
+	assignments := make([]domain.ReviewerAssignment, len(pr.AssignedReviewers))
+	for i, userID := range pr.AssignedReviewers {
+		assignments[i].UserID = userID
+	}
+	if len(pr.RequiredSkills) > 0 && len(assignments) > 0 {
+		if err := s.fillSkills(ctx, pr.RequiredSkills, assignments); err != nil {
+			return err
+		}
+	}
+	pr.MissingSkills = missingSkills(pr.RequiredSkills, assignments)
+	return nil
+}
+
+// reviewersCount — сколько ревьюверов нужно PR: по правилам ревью команды автора, по настройкам
+// репозитория или по умолчанию.
+func reviewersCount(pr *domain.PullRequest, repo *domain.Repository) int {
+	if pr.ReviewersCount > 0 {
+		return pr.ReviewersCount
+	}
+	if repo == nil {
+		return domain.DefaultReviewersCount
+	}
+	return repo.Settings.ReviewersCount
+}
+
+// selectReviewers выбирает ревьюверов PR. Сначала берутся владельцы изменённых путей
+// из CODEOWNERS репозитория (по одному доступному пользователю на владельца, в порядке файлов),
+// затем — кандидаты, покрывающие ещё не покрытые требуемые навыки PR,
+// оставшиеся места заполняются через candidates.
+func (s *Service) selectReviewers(ctx context.Context, draft *domain.PullRequest, repo *domain.Repository, author *domain.User) ([]domain.ReviewerAssignment, error) {
+	want := reviewersCount(draft, repo)
+	excludeUsers, err := s.unavailableUsers(ctx, author.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	assignments, err := s.codeOwnersReviewers(ctx, draft, want, excludeUsers)
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range assignments {
+		excludeUsers = append(excludeUsers, a.UserID)
+	}
+
+	if remaining := want - len(assignments); remaining > 0 && len(draft.RequiredSkills) > 0 {
+		users, err := s.skillReviewers(ctx, draft.RequiredSkills, assignments, repo, author.TeamName, remaining, excludeUsers)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range users {
+			assignments = append(assignments, domain.ReviewerAssignment{
+				UserID: c.user.UserID,
+				Source: c.source,
+			})
+			excludeUsers = append(excludeUsers, c.user.UserID)
+		}
+	}
+
+	if remaining := want - len(assignments); remaining > 0 {
+		users, err := s.candidates(ctx, repo, author.TeamName, remaining, excludeUsers)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range users {
+			assignments = append(assignments, domain.ReviewerAssignment{
+				UserID: c.user.UserID,
+				Source: c.source,
+			})
+		}
+	}
+
+	if len(draft.RequiredSkills) > 0 && len(assignments) > 0 {
+		if err = s.fillSkills(ctx, draft.RequiredSkills, assignments); err != nil {
+			return nil, err
+		}
+	}
+	return assignments, nil
+}
+
+// codeOwnersReviewers выбирает до want владельцев изменённых путей.
+func (s *Service) codeOwnersReviewers(ctx context.Context, draft *domain.PullRequest, want int, excludeUsers []string) ([]domain.ReviewerAssignment, error) {
+	assignments := make([]domain.ReviewerAssignment, 0, want)
+
+	rules, err := s.matchedRules(ctx, draft)
+	if err != nil {
+		return nil, err
+	}
+	for _, rule := range rules {
+		for _, owner := range rule.Owners {
+			if len(assignments) == want {
+				return assignments, nil
+			}
+
+			userHandle, team, ok := domain.ParseOwner(owner)
+			if !ok {
+				continue
+			}
+			var candidates []*domain.User
+			if team != "" {
+				candidates, err = s.repoUser.GetInactiveUsers(ctx, team, candidatesLookahead, excludeUsers)
+			} else {
+				candidates, err = s.repoUser.GetActiveUsersByHandle(ctx, userHandle, excludeUsers)
+			}
+			if err != nil {
+				return nil, err
+			}
+			if candidates, err = s.byWorkingHours(ctx, candidates, 1); err != nil {
+				return nil, err
+			}
+			if len(candidates) == 0 {
+				continue
+			}
+
+			reviewer := candidates[0]
+			excludeUsers = append(excludeUsers, reviewer.UserID)
+			assignments = append(assignments, domain.ReviewerAssignment{
+				UserID:  reviewer.UserID,
+				Source:  domain.AssignmentCodeOwners,
+				Line:    rule.Line,
+				Pattern: rule.Pattern,
+				Owner:   owner,
+			})
+		}
+	}
+	return assignments, nil
+}
+
+// skillCandidatesLimit — сколько доступных пользователей рассматривается при подборе по навыкам.
+const skillCandidatesLimit = 50
+
+// skillReviewers жадно выбирает до limit кандидатов, каждый раз беря того, кто покрывает больше
+// всего требуемых навыков, ещё не покрытых уже выбранными ревьюверами. Кандидаты, не добавляющие
+// ни одного навыка, не выбираются.
+func (s *Service) skillReviewers(
+	ctx context.Context,
+	required []string,
+	chosen []domain.ReviewerAssignment,
+	repo *domain.Repository,
+	teamName string,
+	limit int,
+	excludeUsers []string,
+) ([]candidate, error) {
+	pool, err := s.candidates(ctx, repo, teamName, skillCandidatesLimit, excludeUsers)
+	if err != nil {
+		return nil, err
+	}
+
+	userIDs := make([]string, 0, len(pool)+len(chosen))
+	for _, c := range pool {
+		userIDs = append(userIDs, c.user.UserID)
+	}
+	for _, a := range chosen {
+		userIDs = append(userIDs, a.UserID)
+	}
+	skills, err := s.repoUser.GetSkills(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	uncovered := make(map[string]bool, len(required))
+	for _, skill := range required {
+		uncovered[skill] = true
+	}
+	for _, a := range chosen {
+		for _, skill := range skills[a.UserID] {
+			delete(uncovered, skill)
+		}
+	}
+
+	res := make([]candidate, 0, limit)
+	picked := make([]bool, len(pool))
+	for len(res) < limit && len(uncovered) > 0 {
+		best, bestCount := -1, 0
+		for i, c := range pool {
+			if picked[i] {
+				continue
+			}
+			count := 0
+			for _, skill := range skills[c.user.UserID] {
+				if uncovered[skill] {
+					count++
+				}
+			}
+			if count > bestCount {
+				best, bestCount = i, count
+			}
+		}
+		if best < 0 {
+			break
+		}
+
+		picked[best] = true
+		res = append(res, pool[best])
+		for _, skill := range skills[pool[best].user.UserID] {
+			delete(uncovered, skill)
+		}
+	}
+	return res, nil
+}
+
+// fillSkills записывает в каждое назначение требуемые навыки, которыми владеет ревьювер.
+func (s *Service) fillSkills(ctx context.Context, required []string, assignments []domain.ReviewerAssignment) error {
+	userIDs := make([]string, len(assignments))
+	for i, a := range assignments {
+		userIDs[i] = a.UserID
+	}
+	skills, err := s.repoUser.GetSkills(ctx, userIDs)
+	if err != nil {
+		return err
+	}
+
+	for i := range assignments {
+		for _, skill := range skills[assignments[i].UserID] {
+			if slices.Contains(required, skill) {
+				assignments[i].Skills = append(assignments[i].Skills, skill)
+			}
+		}
+	}
+	return nil
+}
+
+// missingSkills возвращает требуемые навыки, которых нет ни у одного из назначенных ревьюверов.
+func missingSkills(required []string, assignments []domain.ReviewerAssignment) []string {
+	missing := make([]string, 0)
+	for _, skill := range required {
+		covered := slices.ContainsFunc(assignments, func(a domain.ReviewerAssignment) bool {
+			return slices.Contains(a.Skills, skill)
+		})
+		if !covered {
+			missing = append(missing, skill)
+		}
+	}
+	return missing
+}
+
+// candidates подбирает до limit доступных пользователей: из пула репозитория, если он задан,
+// затем из команды teamName, если пула нет или настройки репозитория разрешают её добирать.
+// Внутри каждого источника учитываются рабочие часы кандидатов (см. byWorkingHours).
+func (s *Service) candidates(ctx context.Context, repo *domain.Repository, teamName string, limit int, excludeUsers []string) ([]candidate, error) {
+	res := make([]candidate, 0, limit)
+	if repo != nil && repo.HasPool() {
+		users, err := s.repoUser.GetInactiveUsersByRepository(ctx, repo.ID, max(limit, candidatesLookahead), excludeUsers)
+		if err != nil {
+			return nil, err
+		}
+		if users, err = s.byWorkingHours(ctx, users, limit); err != nil {
+			return nil, err
+		}
+		for _, u := range users {
+			res = append(res, candidate{user: u, source: domain.AssignmentRepository})
+			excludeUsers = append(excludeUsers, u.UserID)
+		}
+		if !repo.Settings.AuthorTeamFallback {
+			return res, nil
+		}
+	}
+
+	if remaining := limit - len(res); remaining > 0 {
+		users, err := s.repoUser.GetInactiveUsers(ctx, teamName, max(remaining, candidatesLookahead), excludeUsers)
+		if err != nil {
+			return nil, err
+		}
+		if users, err = s.byWorkingHours(ctx, users, remaining); err != nil {
+			return nil, err
+		}
+		for _, u := range users {
+			res = append(res, candidate{user: u, source: domain.AssignmentTeam})
+		}
+	}
+	return res, nil
+}
+
+// matchedRules возвращает правила CODEOWNERS, сработавшие для изменённых файлов, без повторов.
+// Если у репозитория нет CODEOWNERS, правил нет и выбор идёт по команде.
+func (s *Service) matchedRules(ctx context.Context, draft *domain.PullRequest) ([]*domain.CodeOwnersRule, error) {
+	if draft.RepositoryID == "" || len(draft.ChangedFiles) == 0 {
+		return nil, nil
+	}
+
+	file, err := s.repoRepository.GetCodeOwners(ctx, draft.RepositoryID)
+	if err != nil {
+		if errors.Is(err, domain.ErrCodeOwnersNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	codeOwners, err := domain.ParseCodeOwners(file.Content)
+	if err != nil {
+		slog.Warn("stored CODEOWNERS is invalid", "repository", draft.RepositoryID, "err", err)
+		return nil, nil
+	}
+
+	seen := make(map[int]bool)
+	var rules []*domain.CodeOwnersRule
+	for _, path := range draft.ChangedFiles {
+		rule := codeOwners.Match(path)
+		if rule == nil || len(rule.Owners) == 0 || seen[rule.Line] {
+			continue
+		}
+		seen[rule.Line] = true
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
@@ -0,0 +1,343 @@
+package pull_request
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/LeoUraltsev/PRReviewerService/internal/clock"
+	"github.com/LeoUraltsev/PRReviewerService/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryStore — пользователи, их навыки, репозитории и CODEOWNERS в памяти.
+// Как и в users, IsActive=true означает, что пользователь свободен.
+type memoryStore struct {
+	users      []*domain.User
+	skills     map[string][]string
+	repos      map[string]*domain.Repository
+	codeOwners map[string]string
+	away       []string
+	hours      map[string]*domain.WorkingHours
+	policies   map[string]domain.MergePolicy
+	rules      map[string][]domain.ReviewRule
+	// prs — PR, по открытым из которых считается нагрузка ревьюверов; лимит у всех 3.
+	prs *memoryPRs
+}
+
+const memoryMaxOpenReviews = 3
+
+// openReviews — число открытых PR, где userID назначен ревьювером, как в хранилище.
+func (m *memoryStore) openReviews(userID string) int {
+	if m.prs == nil {
+		return 0
+	}
+	var n int
+	for _, pr := range m.prs.prs {
+		if pr.Status == domain.Open && slices.Contains(pr.AssignedReviewers, userID) {
+			n++
+		}
+	}
+	return n
+}
+
+func (m *memoryStore) available(limit int, excludeUsers []string, match func(u *domain.User) bool) []*domain.User {
+	var res []*domain.User
+	for _, u := range m.users {
+		if u.IsActive && match(u) && !slices.Contains(excludeUsers, u.UserID) &&
+			m.openReviews(u.UserID) < memoryMaxOpenReviews && len(res) < limit {
+			res = append(res, u)
+		}
+	}
+	return res
+}
+
+func (m *memoryStore) GetInactiveUsers(_ context.Context, teamName string, limit int, excludeUsers []string) ([]*domain.User, error) {
+	return m.available(limit, excludeUsers, func(u *domain.User) bool { return u.TeamName == teamName }), nil
+}
+
+func (m *memoryStore) GetInactiveUsersByRepository(_ context.Context, repositoryID string, limit int, excludeUsers []string) ([]*domain.User, error) {
+	repo := m.repos[repositoryID]
+	return m.available(limit, excludeUsers, func(u *domain.User) bool {
+		return slices.Contains(repo.OwnerTeams, u.TeamName) || slices.Contains(repo.Reviewers, u.UserID)
+	}), nil
+}
+
+func (m *memoryStore) GetActiveUsersByHandle(_ context.Context, handle string, excludeUsers []string) ([]*domain.User, error) {
+	return m.available(len(m.users), excludeUsers, func(u *domain.User) bool {
+		return u.UserID == handle || u.Username == handle
+	}), nil
+}
+
+func (m *memoryStore) GetByID(_ context.Context, userID string) (*domain.User, error) {
+	for _, u := range m.users {
+		if u.UserID == userID {
+			return u, nil
+		}
+	}
+	return nil, domain.ErrUserNotFound
+}
+
+func (m *memoryStore) GetSkills(_ context.Context, userIDs []string) (map[string][]string, error) {
+	res := make(map[string][]string)
+	for _, id := range userIDs {
+		if skills, ok := m.skills[id]; ok {
+			res[id] = skills
+		}
+	}
+	return res, nil
+}
+
+func (m *memoryStore) GetCapacity(ctx context.Context, userID string) (*domain.ReviewerCapacity, error) {
+	u, err := m.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.ReviewerCapacity{UserID: u.UserID, IsActive: u.IsActive, OpenReviews: m.openReviews(userID), MaxOpenReviews: memoryMaxOpenReviews}, nil
+}
+
+func (m *memoryStore) GetOutOfOfficeUserIDs(_ context.Context, _ time.Time) ([]string, error) {
+	return m.away, nil
+}
+
+func (m *memoryStore) GetWorkingHours(_ context.Context, userIDs []string) (map[string]*domain.WorkingHours, error) {
+	res := make(map[string]*domain.WorkingHours)
+	for _, id := range userIDs {
+		if h, ok := m.hours[id]; ok {
+			res[id] = h
+		}
+	}
+	return res, nil
+}
+
+func (m *memoryStore) GetMergePolicy(_ context.Context, teamName string) (domain.MergePolicy, error) {
+	return m.policies[teamName], nil
+}
+
+func (m *memoryStore) GetReviewRules(_ context.Context, teamName string) ([]domain.ReviewRule, error) {
+	return m.rules[teamName], nil
+}
+
+func (m *memoryStore) GetRepository(_ context.Context, repositoryID string) (*domain.Repository, error) {
+	repo, ok := m.repos[repositoryID]
+	if !ok {
+		return nil, domain.ErrRepositoryNotFound
+	}
+	return repo, nil
+}
+
+func (m *memoryStore) GetCodeOwners(_ context.Context, repositoryID string) (*domain.CodeOwnersFile, error) {
+	content, ok := m.codeOwners[repositoryID]
+	if !ok {
+		return nil, domain.ErrCodeOwnersNotFound
+	}
+	return &domain.CodeOwnersFile{RepositoryID: repositoryID, Content: content}, nil
+}
+
+func TestService_SelectReviewers(t *testing.T) {
+	defaults := domain.RepositorySettings{ReviewersCount: 2, AuthorTeamFallback: true}
+	store := &memoryStore{
+		users: []*domain.User{
+			{UserID: "u1", Username: "alice", TeamName: "backend", IsActive: true},
+			{UserID: "u2", Username: "bob", TeamName: "backend", IsActive: true},
+			{UserID: "u3", Username: "carol", TeamName: "platform", IsActive: true},
+			{UserID: "u4", Username: "dan", TeamName: "dba", IsActive: false},
+			{UserID: "u5", Username: "erin", TeamName: "payments", IsActive: true},
+		},
+		repos: map[string]*domain.Repository{
+			"search":  {ID: "search", Settings: defaults},
+			"billing": {ID: "billing", Settings: defaults},
+			"ledger": {ID: "ledger", OwnerTeams: []string{"payments"}, Settings: domain.RepositorySettings{
+				ReviewersCount: 3,
+			}},
+			"gateway": {ID: "gateway", OwnerTeams: []string{"payments"}, Settings: defaults},
+		},
+		codeOwners: map[string]string{
+			"billing": "*.sql @dan\n/deploy/ @acme/platform\n",
+		},
+	}
+	s := NewService(nil, store, store, store, clock.NewFake(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)))
+	author := store.users[0]
+
+	tests := []struct {
+		name  string
+		draft *domain.PullRequest
+		want  []domain.ReviewerAssignment
+	}{
+		{
+			name:  "without repository",
+			draft: &domain.PullRequest{ChangedFiles: []string{"deploy/app.yml"}},
+			want: []domain.ReviewerAssignment{
+				{UserID: "u2", Source: domain.AssignmentTeam},
+			},
+		},
+		{
+			name:  "repository without CODEOWNERS",
+			draft: &domain.PullRequest{RepositoryID: "search", ChangedFiles: []string{"deploy/app.yml"}},
+			want: []domain.ReviewerAssignment{
+				{UserID: "u2", Source: domain.AssignmentTeam},
+			},
+		},
+		{
+			name:  "team owner first, busy user owner skipped",
+			draft: &domain.PullRequest{RepositoryID: "billing", ChangedFiles: []string{"db/1.sql", "/deploy/app.yml"}},
+			want: []domain.ReviewerAssignment{
+				{UserID: "u3", Source: domain.AssignmentCodeOwners, Line: 2, Pattern: "/deploy/", Owner: "@acme/platform"},
+				{UserID: "u2", Source: domain.AssignmentTeam},
+			},
+		},
+		{
+			name:  "pool without author team fallback",
+			draft: &domain.PullRequest{RepositoryID: "ledger"},
+			want: []domain.ReviewerAssignment{
+				{UserID: "u5", Source: domain.AssignmentRepository},
+			},
+		},
+		{
+			name:  "pool with author team fallback",
+			draft: &domain.PullRequest{RepositoryID: "gateway"},
+			want: []domain.ReviewerAssignment{
+				{UserID: "u5", Source: domain.AssignmentRepository},
+				{UserID: "u2", Source: domain.AssignmentTeam},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, err := s.getRepository(context.Background(), tt.draft.RepositoryID)
+			require.NoError(t, err)
+			got, err := s.selectReviewers(context.Background(), tt.draft, repo, author)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	_, err := s.getRepository(context.Background(), "unknown")
+	assert.ErrorIs(t, err, domain.ErrRepositoryNotFound)
+
+	t.Run("out of office skipped", func(t *testing.T) {
+		store.away = []string{"u2"}
+		defer func() { store.away = nil }()
+
+		got, err := s.selectReviewers(context.Background(), &domain.PullRequest{RepositoryID: "gateway"}, store.repos["gateway"], author)
+		require.NoError(t, err)
+		assert.Equal(t, []domain.ReviewerAssignment{
+			{UserID: "u5", Source: domain.AssignmentRepository},
+		}, got)
+	})
+}
+
+func TestService_SelectReviewers_Skills(t *testing.T) {
+	store := &memoryStore{
+		users: []*domain.User{
+			{UserID: "u1", TeamName: "backend", IsActive: true},
+			{UserID: "u2", TeamName: "backend", IsActive: true},
+			{UserID: "u3", TeamName: "backend", IsActive: true},
+			{UserID: "u4", TeamName: "backend", IsActive: true},
+			{UserID: "u5", TeamName: "backend", IsActive: true},
+		},
+		skills: map[string][]string{
+			"u2": {"frontend"},
+			"u3": {"go"},
+			"u4": {"go", "security", "sql"},
+			"u5": {"frontend", "go"},
+		},
+	}
+	s := NewService(nil, store, store, store, clock.NewFake(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)))
+	author := store.users[0]
+
+	tests := []struct {
+		name        string
+		required    []string
+		want        []domain.ReviewerAssignment
+		wantMissing []string
+	}{
+		{
+			name:     "greedy cover",
+			required: []string{"frontend", "security", "sql"},
+			want: []domain.ReviewerAssignment{
+				{UserID: "u4", Source: domain.AssignmentTeam, Skills: []string{"security", "sql"}},
+				{UserID: "u2", Source: domain.AssignmentTeam, Skills: []string{"frontend"}},
+			},
+			wantMissing: []string{},
+		},
+		{
+			name:     "one reviewer covers all, second by team policy",
+			required: []string{"go"},
+			want: []domain.ReviewerAssignment{
+				{UserID: "u3", Source: domain.AssignmentTeam, Skills: []string{"go"}},
+				{UserID: "u2", Source: domain.AssignmentTeam},
+			},
+			wantMissing: []string{},
+		},
+		{
+			name:     "missing skill",
+			required: []string{"rust", "sql"},
+			want: []domain.ReviewerAssignment{
+				{UserID: "u4", Source: domain.AssignmentTeam, Skills: []string{"sql"}},
+				{UserID: "u2", Source: domain.AssignmentTeam},
+			},
+			wantMissing: []string{"rust"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			draft := &domain.PullRequest{RequiredSkills: tt.required}
+			got, err := s.selectReviewers(context.Background(), draft, nil, author)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantMissing, missingSkills(tt.required, got))
+		})
+	}
+}
+
+func TestService_SelectReviewers_WorkingHours(t *testing.T) {
+	weekdays := []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}
+	store := &memoryStore{
+		users: []*domain.User{
+			{UserID: "u1", TeamName: "backend", IsActive: true},
+			{UserID: "u2", TeamName: "backend", IsActive: true},
+			{UserID: "u3", TeamName: "backend", IsActive: true},
+			{UserID: "u4", TeamName: "backend", IsActive: true},
+		},
+		hours: map[string]*domain.WorkingHours{
+			"u2": {TimeZone: "Asia/Tokyo", Days: weekdays, Start: 9 * 60, End: 18 * 60},
+			"u3": {TimeZone: "Europe/Berlin", Days: weekdays, Start: 9 * 60, End: 18 * 60},
+			"u4": {TimeZone: "America/New_York", Days: weekdays, Start: 22 * 60, End: 5 * 60},
+		},
+	}
+	// Среда, 10:00 UTC: в Берлине 11:00, в Токио 19:00, в Нью-Йорке 05:00.
+	clk := clock.NewFake(time.Date(2026, 3, 4, 10, 0, 0, 0, time.UTC))
+	s := NewService(nil, store, store, store, clk)
+	author := store.users[0]
+
+	tests := []struct {
+		name   string
+		policy domain.WorkingHoursPolicy
+		at     time.Time
+		want   []string
+	}{
+		{"prefer working now", domain.WorkingHoursPrefer, clk.Now(), []string{"u3", "u2"}},
+		{"require working now", domain.WorkingHoursRequire, clk.Now(), []string{"u3"}},
+		// Среда, 05:00 UTC: в Токио 14:00, в Нью-Йорке полночь — ночная смена со вторника.
+		{"overnight shift", domain.WorkingHoursRequire, time.Date(2026, 3, 4, 5, 0, 0, 0, time.UTC), []string{"u2", "u4"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, h := range store.hours {
+				h.Policy = tt.policy
+			}
+			clk.Set(tt.at)
+
+			got, err := s.selectReviewers(context.Background(), &domain.PullRequest{}, nil, author)
+			require.NoError(t, err)
+			ids := make([]string, len(got))
+			for i, a := range got {
+				ids[i] = a.UserID
+			}
+			assert.Equal(t, tt.want, ids)
+		})
+	}
+}
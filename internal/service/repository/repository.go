@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/LeoUraltsev/PRReviewerService/internal/domain"
+	"go.opentelemetry.io/otel"
+)
+
+var tracer = otel.Tracer("github.com/LeoUraltsev/PRReviewerService/internal/service/repository")
+
+type RepoRepository interface {
+	Create(ctx context.Context, repo *domain.Repository) (*domain.Repository, error)
+	Update(ctx context.Context, repo *domain.Repository) (*domain.Repository, error)
+	GetRepository(ctx context.Context, repositoryID string) (*domain.Repository, error)
+	ListRepositories(ctx context.Context) ([]*domain.Repository, error)
+	Delete(ctx context.Context, repositoryID string) error
+}
+
+type RepoCodeOwners interface {
+	SetCodeOwners(ctx context.Context, repositoryID string, content string) (*domain.CodeOwnersFile, error)
+	GetCodeOwners(ctx context.Context, repositoryID string) (*domain.CodeOwnersFile, error)
+}
+
+type Service struct {
+	repo       RepoRepository
+	codeOwners RepoCodeOwners
+}
+
+func NewService(repo RepoRepository, codeOwners RepoCodeOwners) *Service {
+	return &Service{
+		repo:       repo,
+		codeOwners: codeOwners,
+	}
+}
+
+func (s *Service) CreateRepository(ctx context.Context, repo *domain.Repository) (*domain.Repository, error) {
+	ctx, span := tracer.Start(ctx, "RepositoryService.CreateRepository")
+	defer span.End()
+
+	if err := normalize(repo); err != nil {
+		return nil, err
+	}
+	return s.repo.Create(ctx, repo)
+}
+
+func (s *Service) UpdateRepository(ctx context.Context, repo *domain.Repository) (*domain.Repository, error) {
+	ctx, span := tracer.Start(ctx, "RepositoryService.UpdateRepository")
+	defer span.End()
+
+	if err := normalize(repo); err != nil {
+		return nil, err
+	}
+	return s.repo.Update(ctx, repo)
+}
+
+func (s *Service) GetRepository(ctx context.Context, repositoryID string) (*domain.Repository, error) {
+	ctx, span := tracer.Start(ctx, "RepositoryService.GetRepository")
+	defer span.End()
+
+	return s.repo.GetRepository(ctx, repositoryID)
+}
+
+func (s *Service) ListRepositories(ctx context.Context) ([]*domain.Repository, error) {
+	ctx, span := tracer.Start(ctx, "RepositoryService.ListRepositories")
+	defer span.End()
+
+	return s.repo.ListRepositories(ctx)
+}
+
+func (s *Service) DeleteRepository(ctx context.Context, repositoryID string) error {
+	ctx, span := tracer.Start(ctx, "RepositoryService.DeleteRepository")
+	defer span.End()
+
+	return s.repo.Delete(ctx, repositoryID)
+}
+
+// SetCodeOwners сохраняет CODEOWNERS репозитория, предварительно проверив, что файл разбирается.
+func (s *Service) SetCodeOwners(ctx context.Context, repositoryID string, content string) (*domain.CodeOwnersFile, error) {
+	ctx, span := tracer.Start(ctx, "RepositoryService.SetCodeOwners")
+	defer span.End()
+
+	if _, err := domain.ParseCodeOwners(content); err != nil {
+		return nil, err
+	}
+	return s.codeOwners.SetCodeOwners(ctx, repositoryID, content)
+}
+
+func (s *Service) GetCodeOwners(ctx context.Context, repositoryID string) (*domain.CodeOwnersFile, error) {
+	ctx, span := tracer.Start(ctx, "RepositoryService.GetCodeOwners")
+	defer span.End()
+
+	return s.codeOwners.GetCodeOwners(ctx, repositoryID)
+}
+
+// normalize подставляет значения по умолчанию: название совпадает с id, ревьюверов двое.
+func normalize(repo *domain.Repository) error {
+	if repo.Name == "" {
+		repo.Name = repo.ID
+	}
+	if repo.Settings.ReviewersCount == 0 {
+		repo.Settings.ReviewersCount = domain.DefaultReviewersCount
+	}
+	return repo.Validate()
+}
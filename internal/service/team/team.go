@@ -4,16 +4,27 @@ import (
 	"context"
 
 	"github.com/LeoUraltsev/PRReviewerService/internal/domain"
+	"go.opentelemetry.io/otel"
 )
 
+var tracer = otel.Tracer("github.com/LeoUraltsev/PRReviewerService/internal/service/team")
+
 type RepoTeam interface {
 	Save(ctx context.Context, teamName string) error
 	CheckExistsTeam(ctx context.Context, teamName string) (bool, error)
+	SetMaxOpenReviews(ctx context.Context, teamName string, limit int) error
+	GetMaxOpenReviews(ctx context.Context, teamName string) (int, error)
+	SetWorkingHoursPolicy(ctx context.Context, teamName string, policy domain.WorkingHoursPolicy) error
+	SetMergePolicy(ctx context.Context, teamName string, policy domain.MergePolicy) error
+	GetMergePolicy(ctx context.Context, teamName string) (domain.MergePolicy, error)
+	SetReviewRules(ctx context.Context, teamName string, rules []domain.ReviewRule) error
+	GetReviewRules(ctx context.Context, teamName string) ([]domain.ReviewRule, error)
 }
 
 type RepoUser interface {
 	SaveUsers(ctx context.Context, user []*domain.User) error
 	GetUsersByTeamName(ctx context.Context, teamName string) ([]*domain.User, error)
+	GetCapacitiesByTeamName(ctx context.Context, teamName string) ([]*domain.ReviewerCapacity, error)
 }
 
 type Service struct {
@@ -30,6 +41,9 @@ func NewService(user RepoUser, team RepoTeam) *Service {
 
 // todo: объеденить в транзакцию
 func (s *Service) Save(ctx context.Context, team *domain.Team) error {
+	ctx, span := tracer.Start(ctx, "TeamService.Save")
+	defer span.End()
+
 	err := s.repo.Save(ctx, team.TeamName)
 	if err != nil {
 		return err
@@ -44,6 +58,9 @@ func (s *Service) Save(ctx context.Context, team *domain.Team) error {
 }
 
 func (s *Service) Get(ctx context.Context, teamName string) (*domain.Team, error) {
+	ctx, span := tracer.Start(ctx, "TeamService.Get")
+	defer span.End()
+
 	exists, err := s.repo.CheckExistsTeam(ctx, teamName)
 	if err != nil {
 		return nil, err
@@ -65,3 +82,92 @@ func (s *Service) Get(ctx context.Context, teamName string) (*domain.Team, error
 
 	return team, nil
 }
+
+// SetTeamMaxOpenReviews задаёт лимит открытых ревью по умолчанию для участников команды
+// и возвращает загрузку команды с новым лимитом.
+func (s *Service) SetTeamMaxOpenReviews(ctx context.Context, teamName string, limit int) (*domain.TeamCapacity, error) {
+	ctx, span := tracer.Start(ctx, "TeamService.SetTeamMaxOpenReviews")
+	defer span.End()
+
+	if err := domain.ValidateMaxOpenReviews(limit); err != nil {
+		return nil, err
+	}
+	if err := s.repo.SetMaxOpenReviews(ctx, teamName, limit); err != nil {
+		return nil, err
+	}
+	return s.GetTeamCapacity(ctx, teamName)
+}
+
+// GetTeamCapacity возвращает загрузку участников команды и сколько ревью им ещё можно назначить.
+func (s *Service) GetTeamCapacity(ctx context.Context, teamName string) (*domain.TeamCapacity, error) {
+	ctx, span := tracer.Start(ctx, "TeamService.GetTeamCapacity")
+	defer span.End()
+
+	limit, err := s.repo.GetMaxOpenReviews(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+	members, err := s.repoUser.GetCapacitiesByTeamName(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.TeamCapacity{
+		TeamName:       teamName,
+		MaxOpenReviews: limit,
+		Members:        members,
+	}, nil
+}
+
+// SetTeamWorkingHoursPolicy задаёт, предпочитать ли при выборе ревьюверов участников команды, у которых
+// сейчас рабочее время, или не назначать остальных вовсе.
+func (s *Service) SetTeamWorkingHoursPolicy(ctx context.Context, teamName string, policy domain.WorkingHoursPolicy) error {
+	ctx, span := tracer.Start(ctx, "TeamService.SetTeamWorkingHoursPolicy")
+	defer span.End()
+
+	if err := policy.Validate(); err != nil {
+		return err
+	}
+	return s.repo.SetWorkingHoursPolicy(ctx, teamName, policy)
+}
+
+// SetTeamMergePolicy задаёт условия merge PR, авторы которых состоят в команде.
+func (s *Service) SetTeamMergePolicy(ctx context.Context, teamName string, policy domain.MergePolicy) error {
+	ctx, span := tracer.Start(ctx, "TeamService.SetTeamMergePolicy")
+	defer span.End()
+
+	if err := policy.Validate(); err != nil {
+		return err
+	}
+	return s.repo.SetMergePolicy(ctx, teamName, policy)
+}
+
+func (s *Service) GetTeamMergePolicy(ctx context.Context, teamName string) (domain.MergePolicy, error) {
+	ctx, span := tracer.Start(ctx, "TeamService.GetTeamMergePolicy")
+	defer span.End()
+
+	return s.repo.GetMergePolicy(ctx, teamName)
+}
+
+// SetTeamReviewRules заменяет правила ревью команды и возвращает их в сохранённом виде:
+// метки и навыки нормализуются так же, как у PR.
+func (s *Service) SetTeamReviewRules(ctx context.Context, teamName string, rules []domain.ReviewRule) ([]domain.ReviewRule, error) {
+	ctx, span := tracer.Start(ctx, "TeamService.SetTeamReviewRules")
+	defer span.End()
+
+	rules, err := domain.NormalizeReviewRules(rules)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.repo.SetReviewRules(ctx, teamName, rules); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+func (s *Service) GetTeamReviewRules(ctx context.Context, teamName string) ([]domain.ReviewRule, error) {
+	ctx, span := tracer.Start(ctx, "TeamService.GetTeamReviewRules")
+	defer span.End()
+
+	return s.repo.GetReviewRules(ctx, teamName)
+}
@@ -4,17 +4,27 @@ import (
 	"context"
 
 	"github.com/LeoUraltsev/PRReviewerService/internal/domain"
+	"go.opentelemetry.io/otel"
 )
 
 //todo: логирование
 
+var tracer = otel.Tracer("github.com/LeoUraltsev/PRReviewerService/internal/service/user")
+
 type RepoPR interface {
-	GetPRByUserID(ctx context.Context, userID string) ([]*domain.PullRequest, error)
+	GetPRByUserID(ctx context.Context, userID string, filter domain.PullRequestFilter) ([]*domain.PullRequest, error)
 }
 
 type RepoUsers interface {
 	CheckExists(ctx context.Context, userID string) error
-	UpdateIsActive(ctx context.Context, userID string, active bool) (*domain.User, error)
+	UpdateIsActive(ctx context.Context, userID string, version int64, active bool) (*domain.User, error)
+	SetMaxOpenReviews(ctx context.Context, userID string, version int64, limit *int) error
+	GetCapacity(ctx context.Context, userID string) (*domain.ReviewerCapacity, error)
+	SetSkills(ctx context.Context, userID string, version int64, skills []string) (int64, error)
+	GetSkills(ctx context.Context, userIDs []string) (map[string][]string, error)
+	SetWorkingHours(ctx context.Context, hours *domain.WorkingHours) error
+	GetWorkingHours(ctx context.Context, userIDs []string) (map[string]*domain.WorkingHours, error)
+	DeleteWorkingHours(ctx context.Context, userID string) error
 }
 
 type Service struct {
@@ -29,24 +39,123 @@ func NewService(pr RepoPR, users RepoUsers) *Service {
 	}
 }
 
-func (s *Service) GetUserPullRequest(ctx context.Context, userId string) ([]*domain.PullRequest, error) {
+// GetUserPullRequest возвращает PR, ревьювером которых назначен пользователь и которые подходят под filter.
+func (s *Service) GetUserPullRequest(ctx context.Context, userId string, filter domain.PullRequestFilter) ([]*domain.PullRequest, error) {
+	ctx, span := tracer.Start(ctx, "UserService.GetUserPullRequest")
+	defer span.End()
+
 	err := s.repoUsers.CheckExists(ctx, userId)
 	if err != nil {
 		return nil, err
 	}
 
-	pr, err := s.repoPR.GetPRByUserID(ctx, userId)
+	return s.repoPR.GetPRByUserID(ctx, userId, filter.Normalize())
+}
+
+// UpdateIsActive меняет флаг активности пользователя, если его версия равна version (0 — без проверки).
+func (s *Service) UpdateIsActive(ctx context.Context, userId string, version int64, isActive bool) (*domain.User, error) {
+	ctx, span := tracer.Start(ctx, "UserService.UpdateIsActive")
+	defer span.End()
+
+	user, err := s.repoUsers.UpdateIsActive(ctx, userId, version, isActive)
 	if err != nil {
 		return nil, err
 	}
+	return user, nil
+}
+
+// SetUserSkills заменяет навыки пользователя версии version (0 — без проверки); навыки приводятся
+// к нижнему регистру без повторов.
+func (s *Service) SetUserSkills(ctx context.Context, userID string, version int64, skills []string) (*domain.UserSkills, error) {
+	ctx, span := tracer.Start(ctx, "UserService.SetUserSkills")
+	defer span.End()
 
-	return pr, nil
+	skills, err := domain.NormalizeSkills(skills)
+	if err != nil {
+		return nil, err
+	}
+	version, err = s.repoUsers.SetSkills(ctx, userID, version, skills)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.UserSkills{UserID: userID, Skills: skills, Version: version}, nil
 }
 
-func (s *Service) UpdateIsActive(ctx context.Context, userId string, isActive bool) (*domain.User, error) {
-	user, err := s.repoUsers.UpdateIsActive(ctx, userId, isActive)
+func (s *Service) GetUserSkills(ctx context.Context, userID string) (*domain.UserSkills, error) {
+	ctx, span := tracer.Start(ctx, "UserService.GetUserSkills")
+	defer span.End()
+
+	if err := s.repoUsers.CheckExists(ctx, userID); err != nil {
+		return nil, err
+	}
+	skills, err := s.repoUsers.GetSkills(ctx, []string{userID})
 	if err != nil {
 		return nil, err
 	}
-	return user, nil
+
+	userSkills := skills[userID]
+	if userSkills == nil {
+		userSkills = []string{}
+	}
+	return &domain.UserSkills{UserID: userID, Skills: userSkills}, nil
+}
+
+// SetUserMaxOpenReviews задаёт пользователю версии version (0 — без проверки) лимит открытых ревью;
+// nil — использовать лимит команды.
+func (s *Service) SetUserMaxOpenReviews(ctx context.Context, userID string, version int64, limit *int) (*domain.ReviewerCapacity, error) {
+	ctx, span := tracer.Start(ctx, "UserService.SetUserMaxOpenReviews")
+	defer span.End()
+
+	if limit != nil {
+		if err := domain.ValidateMaxOpenReviews(*limit); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.repoUsers.SetMaxOpenReviews(ctx, userID, version, limit); err != nil {
+		return nil, err
+	}
+	return s.repoUsers.GetCapacity(ctx, userID)
+}
+
+// SetUserWorkingHours задаёт рабочее расписание пользователя и возвращает его вместе с политикой команды.
+func (s *Service) SetUserWorkingHours(ctx context.Context, hours *domain.WorkingHours) (*domain.WorkingHours, error) {
+	ctx, span := tracer.Start(ctx, "UserService.SetUserWorkingHours")
+	defer span.End()
+
+	if err := hours.Validate(); err != nil {
+		return nil, err
+	}
+	if err := s.repoUsers.SetWorkingHours(ctx, hours); err != nil {
+		return nil, err
+	}
+	return s.GetUserWorkingHours(ctx, hours.UserID)
+}
+
+func (s *Service) GetUserWorkingHours(ctx context.Context, userID string) (*domain.WorkingHours, error) {
+	ctx, span := tracer.Start(ctx, "UserService.GetUserWorkingHours")
+	defer span.End()
+
+	if err := s.repoUsers.CheckExists(ctx, userID); err != nil {
+		return nil, err
+	}
+	hours, err := s.repoUsers.GetWorkingHours(ctx, []string{userID})
+	if err != nil {
+		return nil, err
+	}
+	h, ok := hours[userID]
+	if !ok {
+		return nil, domain.ErrWorkingHoursNotFound
+	}
+	return h, nil
+}
+
+// DeleteUserWorkingHours удаляет расписание: пользователь снова считается доступным в любое время.
+func (s *Service) DeleteUserWorkingHours(ctx context.Context, userID string) error {
+	ctx, span := tracer.Start(ctx, "UserService.DeleteUserWorkingHours")
+	defer span.End()
+
+	if err := s.repoUsers.CheckExists(ctx, userID); err != nil {
+		return err
+	}
+	return s.repoUsers.DeleteWorkingHours(ctx, userID)
 }
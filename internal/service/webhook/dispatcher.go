@@ -0,0 +1,149 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"github.com/LeoUraltsev/PRReviewerService/internal/domain"
+)
+
+const (
+	HeaderSignature = "X-Webhook-Signature-256"
+	HeaderEvent     = "X-Webhook-Event"
+	HeaderEventID   = "X-Webhook-Event-Id"
+	HeaderAttempt   = "X-Webhook-Attempt"
+)
+
+type RepoDeliveries interface {
+	GetSubscriptionsByEvent(ctx context.Context, eventType domain.EventType) ([]*domain.WebhookSubscription, error)
+	GetDeliveriesByEvent(ctx context.Context, eventID string) ([]*domain.WebhookDelivery, error)
+	SaveDelivery(ctx context.Context, d *domain.WebhookDelivery) error
+}
+
+// Dispatcher рассылает события подписчикам, подписывая тело HMAC-SHA256 секретом подписки.
+// За один вызов Deliver каждому подписчику отправляется одна попытка без пауз: повторы с
+// экспоненциальной паузой выполняет relay outbox, а подписчики, которым событие уже доставлено,
+// при повторе пропускаются по журналу доставок.
+type Dispatcher struct {
+	log    *slog.Logger
+	repo   RepoDeliveries
+	client *http.Client
+}
+
+func NewDispatcher(log *slog.Logger, repo RepoDeliveries, client *http.Client) *Dispatcher {
+	return &Dispatcher{
+		log:    log,
+		repo:   repo,
+		client: client,
+	}
+}
+
+func (d *Dispatcher) Name() string {
+	return "webhook"
+}
+
+// Deliver отправляет событие подписчикам, которые ещё не получили его, и возвращает ошибки тех,
+// кому доставить его не удалось. Dispatcher используется relay как sink outbox.
+func (d *Dispatcher) Deliver(ctx context.Context, event domain.Event) error {
+	ctx, span := tracer.Start(ctx, "WebhookDispatcher.Deliver")
+	defer span.End()
+
+	subs, err := d.repo.GetSubscriptionsByEvent(ctx, event.Type)
+	if err != nil {
+		return err
+	}
+	if len(subs) == 0 {
+		return nil
+	}
+
+	previous, err := d.repo.GetDeliveriesByEvent(ctx, event.ID)
+	if err != nil {
+		return err
+	}
+	attempts := make(map[string]int)
+	delivered := make(map[string]bool)
+	for _, p := range previous {
+		attempts[p.SubscriptionID] = max(attempts[p.SubscriptionID], p.Attempt)
+		delivered[p.SubscriptionID] = delivered[p.SubscriptionID] || p.Success
+	}
+
+	body, err := json.Marshal(NewPayload(event))
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, sub := range subs {
+		if delivered[sub.ID] {
+			continue
+		}
+		if err = d.deliverTo(ctx, sub, event, body, attempts[sub.ID]+1); err != nil {
+			errs = append(errs, fmt.Errorf("subscription %s: %w", sub.ID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// deliverTo отправляет одну попытку доставки и записывает её в журнал.
+func (d *Dispatcher) deliverTo(ctx context.Context, sub *domain.WebhookSubscription, event domain.Event, body []byte, attempt int) error {
+	status, err := d.send(ctx, sub, event, body, attempt)
+	delivery := &domain.WebhookDelivery{
+		SubscriptionID: sub.ID,
+		EventID:        event.ID,
+		EventType:      event.Type,
+		Attempt:        attempt,
+		Success:        err == nil,
+	}
+	if status != 0 {
+		delivery.StatusCode = &status
+	}
+	if err != nil {
+		msg := err.Error()
+		delivery.Error = &msg
+	}
+	if saveErr := d.repo.SaveDelivery(ctx, delivery); saveErr != nil {
+		// Без записи об успехе подписчик получит событие повторно: доставка at-least-once.
+		d.log.Warn("failed to save webhook delivery", "subscription", sub.ID, "err", saveErr)
+	}
+	return err
+}
+
+func (d *Dispatcher) send(ctx context.Context, sub *domain.WebhookSubscription, event domain.Event, body []byte, attempt int) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sub.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEvent, event.Type.String())
+	req.Header.Set(HeaderEventID, event.ID)
+	req.Header.Set(HeaderAttempt, fmt.Sprint(attempt))
+	req.Header.Set(HeaderSignature, Sign(sub.Secret, body))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+// Sign возвращает значение заголовка подписи: "sha256=" и HMAC-SHA256 тела в hex.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
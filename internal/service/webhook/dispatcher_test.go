@@ -0,0 +1,108 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/LeoUraltsev/PRReviewerService/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type memoryRepo struct {
+	mu         sync.Mutex
+	subs       []*domain.WebhookSubscription
+	deliveries []*domain.WebhookDelivery
+}
+
+func (m *memoryRepo) GetSubscriptionsByEvent(_ context.Context, eventType domain.EventType) ([]*domain.WebhookSubscription, error) {
+	var res []*domain.WebhookSubscription
+	for _, sub := range m.subs {
+		if sub.Subscribed(eventType) {
+			res = append(res, sub)
+		}
+	}
+	return res, nil
+}
+
+func (m *memoryRepo) GetDeliveriesByEvent(_ context.Context, eventID string) ([]*domain.WebhookDelivery, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var res []*domain.WebhookDelivery
+	for _, d := range m.deliveries {
+		if d.EventID == eventID {
+			res = append(res, d)
+		}
+	}
+	return res, nil
+}
+
+func (m *memoryRepo) SaveDelivery(_ context.Context, d *domain.WebhookDelivery) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.deliveries = append(m.deliveries, d)
+	return nil
+}
+
+func TestDispatcher_Deliver(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		received = map[string][]string{}
+		failing  atomic.Bool
+	)
+	failing.Store(true)
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		secret := r.URL.Query().Get("secret")
+		assert.Equal(t, Sign(secret, body), r.Header.Get(HeaderSignature))
+		assert.Equal(t, "reviewer.assigned", r.Header.Get(HeaderEvent))
+
+		var p Payload
+		assert.NoError(t, json.Unmarshal(body, &p))
+		assert.Equal(t, "u2", p.ReviewerID)
+		assert.Equal(t, "pr-1", p.PullRequest.ID)
+
+		mu.Lock()
+		received[secret] = append(received[secret], r.Header.Get(HeaderAttempt))
+		mu.Unlock()
+		if secret == "flaky" && failing.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer receiver.Close()
+
+	repo := &memoryRepo{subs: []*domain.WebhookSubscription{
+		{ID: "sub-1", URL: receiver.URL + "?secret=s3cret", Secret: "s3cret", Events: []domain.EventType{domain.EventReviewerAssigned}},
+		{ID: "sub-2", URL: receiver.URL + "?secret=flaky", Secret: "flaky", Events: []domain.EventType{domain.EventReviewerAssigned}},
+		{ID: "sub-3", URL: receiver.URL + "?secret=other", Secret: "other", Events: []domain.EventType{domain.EventPRMerged}},
+	}}
+	d := NewDispatcher(slog.New(slog.NewTextHandler(io.Discard, nil)), repo, receiver.Client())
+
+	event := domain.NewEvent(domain.EventReviewerAssigned)
+	event.PullRequest = &domain.PullRequest{ID: "pr-1", Status: domain.Open}
+	event.ReviewerID = "u2"
+
+	// Одна попытка на подписчика без пауз: повтор выполнит relay outbox.
+	err := d.Deliver(context.Background(), event)
+	require.ErrorContains(t, err, "subscription sub-2")
+	assert.Equal(t, map[string][]string{"s3cret": {"1"}, "flaky": {"1"}}, received)
+	require.Len(t, repo.deliveries, 2)
+	assert.Equal(t, http.StatusServiceUnavailable, *repo.deliveries[1].StatusCode)
+
+	// При повторе событие получает только подписчик, которому его ещё не доставили.
+	failing.Store(false)
+	require.NoError(t, d.Deliver(context.Background(), event))
+	assert.Equal(t, map[string][]string{"s3cret": {"1"}, "flaky": {"1", "2"}}, received)
+	require.Len(t, repo.deliveries, 3)
+	assert.Equal(t, "sub-2", repo.deliveries[2].SubscriptionID)
+	assert.True(t, repo.deliveries[2].Success)
+}
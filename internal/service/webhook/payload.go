@@ -0,0 +1,53 @@
+package webhook
+
+import (
+	"time"
+
+	"github.com/LeoUraltsev/PRReviewerService/internal/domain"
+)
+
+// Payload — тело вебхука. В том же формате события отдают и остальные получатели outbox.
+type Payload struct {
+	ID            string              `json:"id"`
+	Type          string              `json:"type"`
+	OccurredAt    time.Time           `json:"occurred_at"`
+	PullRequest   *PayloadPullRequest `json:"pull_request,omitempty"`
+	ReviewerID    string              `json:"reviewer_id,omitempty"`
+	OldReviewerID string              `json:"old_reviewer_id,omitempty"`
+	Verdict       string              `json:"verdict,omitempty"`
+}
+
+type PayloadPullRequest struct {
+	ID                string     `json:"pull_request_id"`
+	Name              string     `json:"pull_request_name"`
+	AuthorID          string     `json:"author_id"`
+	Status            string     `json:"status"`
+	AssignedReviewers []string   `json:"assigned_reviewers"`
+	NeedMoreReviewers bool       `json:"need_more_reviewers"`
+	CreatedAt         time.Time  `json:"createdAt"`
+	MergedAt          *time.Time `json:"mergedAt"`
+}
+
+func NewPayload(event domain.Event) Payload {
+	p := Payload{
+		ID:            event.ID,
+		Type:          event.Type.String(),
+		OccurredAt:    event.OccurredAt,
+		ReviewerID:    event.ReviewerID,
+		OldReviewerID: event.OldReviewerID,
+		Verdict:       event.Verdict.String(),
+	}
+	if pr := event.PullRequest; pr != nil {
+		p.PullRequest = &PayloadPullRequest{
+			ID:                pr.ID,
+			Name:              pr.Name,
+			AuthorID:          pr.AuthorID,
+			Status:            pr.Status.String(),
+			AssignedReviewers: pr.AssignedReviewers,
+			NeedMoreReviewers: pr.NeedMoreReviewers,
+			CreatedAt:         pr.CreatedAt,
+			MergedAt:          pr.MergedAt,
+		}
+	}
+	return p
+}
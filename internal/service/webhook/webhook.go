@@ -0,0 +1,73 @@
+package webhook
+
+import (
+	"context"
+	"crypto/rand"
+	"net/url"
+
+	"github.com/LeoUraltsev/PRReviewerService/internal/domain"
+	"go.opentelemetry.io/otel"
+)
+
+var tracer = otel.Tracer("github.com/LeoUraltsev/PRReviewerService/internal/service/webhook")
+
+const defaultDeliveriesLimit = 50
+
+type RepoSubscriptions interface {
+	SaveSubscription(ctx context.Context, sub *domain.WebhookSubscription) (*domain.WebhookSubscription, error)
+	DeleteSubscription(ctx context.Context, id string) error
+	GetSubscription(ctx context.Context, id string) (*domain.WebhookSubscription, error)
+	GetDeliveries(ctx context.Context, subscriptionID string, limit int) ([]*domain.WebhookDelivery, error)
+}
+
+type Service struct {
+	repo RepoSubscriptions
+}
+
+func NewService(repo RepoSubscriptions) *Service {
+	return &Service{
+		repo: repo,
+	}
+}
+
+// Subscribe регистрирует URL на указанные события. Если секрет не передан, он генерируется
+// и возвращается в ответе — это единственный момент, когда его можно получить.
+func (s *Service) Subscribe(ctx context.Context, rawURL string, events []domain.EventType, secret string) (*domain.WebhookSubscription, error) {
+	ctx, span := tracer.Start(ctx, "WebhookService.Subscribe")
+	defer span.End()
+
+	u, err := url.Parse(rawURL)
+	if err != nil || !u.IsAbs() || (u.Scheme != "http" && u.Scheme != "https") {
+		return nil, domain.ErrInvalidWebhookURL
+	}
+	if secret == "" {
+		secret = rand.Text()
+	}
+
+	return s.repo.SaveSubscription(ctx, &domain.WebhookSubscription{
+		ID:     rand.Text(),
+		URL:    u.String(),
+		Secret: secret,
+		Events: events,
+	})
+}
+
+func (s *Service) Unsubscribe(ctx context.Context, id string) error {
+	ctx, span := tracer.Start(ctx, "WebhookService.Unsubscribe")
+	defer span.End()
+
+	return s.repo.DeleteSubscription(ctx, id)
+}
+
+func (s *Service) GetDeliveries(ctx context.Context, subscriptionID string, limit int) ([]*domain.WebhookDelivery, error) {
+	ctx, span := tracer.Start(ctx, "WebhookService.GetDeliveries")
+	defer span.End()
+
+	if _, err := s.repo.GetSubscription(ctx, subscriptionID); err != nil {
+		return nil, err
+	}
+	if limit <= 0 {
+		limit = defaultDeliveriesLimit
+	}
+	return s.repo.GetDeliveries(ctx, subscriptionID, limit)
+}
@@ -0,0 +1,126 @@
+package forge
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/LeoUraltsev/PRReviewerService/internal/domain"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type Storage struct {
+	log  *slog.Logger
+	pool *pgxpool.Pool
+}
+
+func NewStorage(log *slog.Logger, pool *pgxpool.Pool) *Storage {
+	return &Storage{
+		log:  log,
+		pool: pool,
+	}
+}
+
+// LinkAccount связывает логин во внешнем форже с пользователем; повторная связь перезаписывает старую.
+func (s *Storage) LinkAccount(ctx context.Context, account *domain.ForgeAccount) error {
+	q := `INSERT INTO forge_accounts (provider, login, user_id) VALUES ($1, $2, $3)
+	ON CONFLICT (provider, login) DO UPDATE SET user_id = excluded.user_id`
+	_, err := s.pool.Exec(ctx, q, account.Provider.String(), account.Login, account.UserID)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.ForeignKeyViolation {
+			return domain.ErrUserNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *Storage) GetUserIDByLogin(ctx context.Context, provider domain.ForgeProvider, login string) (string, error) {
+	q := `SELECT user_id FROM forge_accounts WHERE provider = $1 AND login = $2`
+	var userID string
+	err := s.pool.QueryRow(ctx, q, provider.String(), login).Scan(&userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", domain.ErrForgeAccountNotFound
+		}
+		return "", err
+	}
+	return userID, nil
+}
+
+func (s *Storage) AddRepository(ctx context.Context, provider domain.ForgeProvider, fullName string) (*domain.ForgeRepository, error) {
+	q := `INSERT INTO forge_repositories (provider, full_name) VALUES ($1, $2)
+	ON CONFLICT (provider, full_name) DO UPDATE SET full_name = excluded.full_name
+	RETURNING provider, full_name, created_at`
+	var (
+		repo    domain.ForgeRepository
+		rawProv string
+	)
+	err := s.pool.QueryRow(ctx, q, provider.String(), fullName).Scan(&rawProv, &repo.FullName, &repo.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	repo.Provider = domain.ForgeProvider(rawProv)
+	return &repo, nil
+}
+
+func (s *Storage) RepositoryExists(ctx context.Context, provider domain.ForgeProvider, fullName string) (bool, error) {
+	q := `SELECT EXISTS (SELECT 1 FROM forge_repositories WHERE provider = $1 AND full_name = $2)`
+	var exists bool
+	if err := s.pool.QueryRow(ctx, q, provider.String(), fullName).Scan(&exists); err != nil {
+		return false, err
+	}
+	return exists, nil
+}
+
+func (s *Storage) GetPullRequestRef(ctx context.Context, provider domain.ForgeProvider, repository string, number int64) (*domain.ForgePullRequest, error) {
+	q := `SELECT pr_id FROM forge_pull_requests WHERE provider = $1 AND repository = $2 AND number = $3`
+	ref := &domain.ForgePullRequest{
+		Provider:   provider,
+		Repository: repository,
+		Number:     number,
+	}
+	err := s.pool.QueryRow(ctx, q, provider.String(), repository, number).Scan(&ref.PullRequestID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrForgePRNotFound
+		}
+		return nil, err
+	}
+	return ref, nil
+}
+
+func (s *Storage) GetPullRequestRefByID(ctx context.Context, prID string) (*domain.ForgePullRequest, error) {
+	q := `SELECT provider, repository, number FROM forge_pull_requests WHERE pr_id = $1`
+	var (
+		ref      = &domain.ForgePullRequest{PullRequestID: prID}
+		provider string
+	)
+	err := s.pool.QueryRow(ctx, q, prID).Scan(&provider, &ref.Repository, &ref.Number)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrForgePRNotFound
+		}
+		return nil, err
+	}
+	ref.Provider = domain.ForgeProvider(provider)
+	return ref, nil
+}
+
+// GetLoginByUserID возвращает логин пользователя в форже; если связей несколько, берётся последняя.
+func (s *Storage) GetLoginByUserID(ctx context.Context, provider domain.ForgeProvider, userID string) (string, error) {
+	q := `SELECT login FROM forge_accounts WHERE provider = $1 AND user_id = $2 ORDER BY created_at DESC LIMIT 1`
+	var login string
+	err := s.pool.QueryRow(ctx, q, provider.String(), userID).Scan(&login)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", domain.ErrForgeAccountNotFound
+		}
+		return "", err
+	}
+	return login, nil
+}
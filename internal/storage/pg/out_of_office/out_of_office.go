@@ -0,0 +1,98 @@
+package out_of_office
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/LeoUraltsev/PRReviewerService/internal/domain"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type Storage struct {
+	log  *slog.Logger
+	pool *pgxpool.Pool
+}
+
+func NewStorage(log *slog.Logger, pool *pgxpool.Pool) *Storage {
+	return &Storage{
+		log:  log,
+		pool: pool,
+	}
+}
+
+const selectPeriod = `SELECT id, user_id, starts_at, ends_at, reason, reassign_reviews, reassigned_at, created_at FROM out_of_office`
+
+func (s *Storage) Save(ctx context.Context, period *domain.OutOfOffice) (*domain.OutOfOffice, error) {
+	q := `INSERT INTO out_of_office (user_id, starts_at, ends_at, reason, reassign_reviews) VALUES ($1, $2, $3, $4, $5)
+	RETURNING id, user_id, starts_at, ends_at, reason, reassign_reviews, reassigned_at, created_at`
+
+	var o domain.OutOfOffice
+	err := scanPeriod(s.pool.QueryRow(ctx, q,
+		period.UserID, period.StartsAt.UTC(), period.EndsAt.UTC(), period.Reason, period.ReassignReviews,
+	), &o)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.ForeignKeyViolation {
+			return nil, domain.ErrUserNotFound
+		}
+		return nil, err
+	}
+	return &o, nil
+}
+
+// ListByUser возвращает периоды пользователя, которые заканчиваются после from, по времени начала.
+func (s *Storage) ListByUser(ctx context.Context, userID string, from time.Time) ([]*domain.OutOfOffice, error) {
+	return s.query(ctx, selectPeriod+` WHERE user_id = $1 AND ends_at > $2 ORDER BY starts_at, id`, userID, from.UTC())
+}
+
+func (s *Storage) Delete(ctx context.Context, id int64) error {
+	tag, err := s.pool.Exec(ctx, `DELETE FROM out_of_office WHERE id = $1`, id)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrOutOfOfficeNotFound
+	}
+	return nil
+}
+
+// Due возвращает начавшиеся и ещё идущие периоды с переназначением ревью, которые не обработаны.
+func (s *Storage) Due(ctx context.Context, at time.Time, limit int) ([]*domain.OutOfOffice, error) {
+	q := selectPeriod + ` WHERE reassign_reviews AND reassigned_at IS NULL AND starts_at <= $1 AND ends_at > $1
+	ORDER BY starts_at, id LIMIT $2`
+	return s.query(ctx, q, at.UTC(), limit)
+}
+
+// MarkReassigned отмечает период обработанным; время, записанное первой репликой, не перезаписывается.
+func (s *Storage) MarkReassigned(ctx context.Context, id int64, at time.Time) error {
+	q := `UPDATE out_of_office SET reassigned_at = $2 WHERE id = $1 AND reassigned_at IS NULL`
+	_, err := s.pool.Exec(ctx, q, id, at.UTC())
+	return err
+}
+
+func (s *Storage) query(ctx context.Context, q string, args ...any) ([]*domain.OutOfOffice, error) {
+	rows, err := s.pool.Query(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	periods := make([]*domain.OutOfOffice, 0)
+	for rows.Next() {
+		var o domain.OutOfOffice
+		if err = scanPeriod(rows, &o); err != nil {
+			return nil, err
+		}
+		periods = append(periods, &o)
+	}
+	return periods, rows.Err()
+}
+
+func scanPeriod(row pgx.Row, o *domain.OutOfOffice) error {
+	return row.Scan(&o.ID, &o.UserID, &o.StartsAt, &o.EndsAt, &o.Reason, &o.ReassignReviews, &o.ReassignedAt, &o.CreatedAt)
+}
@@ -0,0 +1,272 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/LeoUraltsev/PRReviewerService/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// lockNamespace отделяет advisory-локи outbox от любых других локов в той же базе.
+const lockNamespace = 4201
+
+type Storage struct {
+	log  *slog.Logger
+	pool *pgxpool.Pool
+}
+
+func NewStorage(log *slog.Logger, pool *pgxpool.Pool) *Storage {
+	return &Storage{
+		log:  log,
+		pool: pool,
+	}
+}
+
+type message struct {
+	ID            string       `json:"id"`
+	Type          string       `json:"type"`
+	OccurredAt    time.Time    `json:"occurred_at"`
+	PullRequest   *pullRequest `json:"pull_request,omitempty"`
+	ReviewerID    string       `json:"reviewer_id,omitempty"`
+	OldReviewerID string       `json:"old_reviewer_id,omitempty"`
+	Verdict       string       `json:"verdict,omitempty"`
+}
+
+type pullRequest struct {
+	ID                string     `json:"id"`
+	Name              string     `json:"name"`
+	AuthorID          string     `json:"author_id"`
+	Status            string     `json:"status"`
+	AssignedReviewers []string   `json:"assigned_reviewers"`
+	NeedMoreReviewers bool       `json:"need_more_reviewers"`
+	CreatedAt         time.Time  `json:"created_at"`
+	MergedAt          *time.Time `json:"merged_at"`
+}
+
+// Insert записывает события в outbox в рамках переданной транзакции,
+// чтобы они публиковались тогда и только тогда, когда закоммичено само изменение.
+func Insert(ctx context.Context, tx pgx.Tx, events []domain.Event) error {
+	q := `INSERT INTO outbox (event_id, event_type, aggregate_id, payload) VALUES ($1, $2, $3, $4)`
+	for _, event := range events {
+		if event.PullRequest == nil {
+			return fmt.Errorf("event %s has no pull request snapshot", event.ID)
+		}
+		payload, err := json.Marshal(toMessage(event))
+		if err != nil {
+			return err
+		}
+		_, err = tx.Exec(ctx, q, event.ID, event.Type.String(), event.PullRequest.ID, payload)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PendingAggregates возвращает PR, у которых самое раннее неопубликованное событие уже пора отправлять
+// и оно не арендовано другой репликой. Более поздние события PR не выбираются, пока не опубликовано
+// предыдущее, — так сохраняется порядок.
+func (s *Storage) PendingAggregates(ctx context.Context, limit int) ([]string, error) {
+	q := `SELECT aggregate_id FROM (
+		SELECT DISTINCT ON (aggregate_id) aggregate_id, id, next_attempt_at, leased_until
+		FROM outbox
+		WHERE published_at IS NULL AND failed_at IS NULL
+		ORDER BY aggregate_id, id
+	) head
+	WHERE next_attempt_at <= timezone('utc', now())
+	AND (leased_until IS NULL OR leased_until <= timezone('utc', now()))
+	ORDER BY id
+	LIMIT $1`
+	rows, err := s.pool.Query(ctx, q, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ids := make([]string, 0)
+	for rows.Next() {
+		var id string
+		if err = rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+// Claim арендует неопубликованные события PR до until и возвращает их по порядку вместе с получателями,
+// которые уже получили каждое событие. Транзакция с advisory-локом держится только на время аренды,
+// доставка выполняется после её коммита. Если события PR уже арендованы другой репликой
+// или лок занят, возвращается пустой список.
+func (s *Storage) Claim(ctx context.Context, aggregateID string, limit int, until time.Time) ([]*domain.OutboxMessage, error) {
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(context.Background())
+
+	var locked bool
+	q := `SELECT pg_try_advisory_xact_lock($1, hashtext($2))`
+	if err = tx.QueryRow(ctx, q, lockNamespace, aggregateID).Scan(&locked); err != nil {
+		return nil, err
+	}
+	if !locked {
+		return nil, nil
+	}
+
+	q = `SELECT id, aggregate_id, attempts, payload,
+	leased_until IS NOT NULL AND leased_until > timezone('utc', now())
+	FROM outbox
+	WHERE aggregate_id = $1 AND published_at IS NULL AND failed_at IS NULL
+	ORDER BY id
+	LIMIT $2`
+	rows, err := tx.Query(ctx, q, aggregateID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	messages := make([]*domain.OutboxMessage, 0)
+	byID := make(map[int64]*domain.OutboxMessage)
+	ids := make([]int64, 0)
+	for rows.Next() {
+		var (
+			msg     domain.OutboxMessage
+			payload []byte
+			leased  bool
+		)
+		if err = rows.Scan(&msg.ID, &msg.AggregateID, &msg.Attempts, &payload, &leased); err != nil {
+			return nil, err
+		}
+		if leased {
+			return nil, nil
+		}
+		var m message
+		if err = json.Unmarshal(payload, &m); err != nil {
+			return nil, fmt.Errorf("failed decode outbox message %d: %w", msg.ID, err)
+		}
+		msg.Event = toDomainEvent(&m)
+		messages = append(messages, &msg)
+		byID[msg.ID] = &msg
+		ids = append(ids, msg.ID)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+	if len(messages) == 0 {
+		return messages, nil
+	}
+
+	q = `UPDATE outbox SET leased_until = $2 WHERE id = ANY($1)`
+	if _, err = tx.Exec(ctx, q, ids, until.UTC()); err != nil {
+		return nil, err
+	}
+
+	q = `SELECT outbox_id, sink FROM outbox_deliveries WHERE outbox_id = ANY($1)`
+	deliveries, err := tx.Query(ctx, q, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer deliveries.Close()
+	for deliveries.Next() {
+		var (
+			id   int64
+			sink string
+		)
+		if err = deliveries.Scan(&id, &sink); err != nil {
+			return nil, err
+		}
+		byID[id].Delivered = append(byID[id].Delivered, sink)
+	}
+	if err = deliveries.Err(); err != nil {
+		return nil, err
+	}
+	deliveries.Close()
+
+	return messages, tx.Commit(ctx)
+}
+
+// Release снимает аренду с событий, которые relay не стал обрабатывать.
+func (s *Storage) Release(ctx context.Context, ids []int64) error {
+	q := `UPDATE outbox SET leased_until = NULL WHERE id = ANY($1)`
+	_, err := s.pool.Exec(ctx, q, ids)
+	return err
+}
+
+// MarkDelivered запоминает, что получатель sink уже получил событие, чтобы при повторе не отправлять его снова.
+func (s *Storage) MarkDelivered(ctx context.Context, id int64, sink string) error {
+	q := `INSERT INTO outbox_deliveries (outbox_id, sink) VALUES ($1, $2) ON CONFLICT DO NOTHING`
+	_, err := s.pool.Exec(ctx, q, id, sink)
+	return err
+}
+
+func (s *Storage) MarkPublished(ctx context.Context, id int64) error {
+	q := `UPDATE outbox SET published_at = timezone('utc', now()), attempts = attempts + 1, last_error = NULL,
+	leased_until = NULL
+	WHERE id = $1`
+	_, err := s.pool.Exec(ctx, q, id)
+	return err
+}
+
+// MarkFailed откладывает событие до nextAttemptAt; при dead == true событие больше не публикуется.
+func (s *Storage) MarkFailed(ctx context.Context, id int64, lastErr string, nextAttemptAt time.Time, dead bool) error {
+	q := `UPDATE outbox SET attempts = attempts + 1, last_error = $2, next_attempt_at = $3,
+	failed_at = CASE WHEN $4 THEN timezone('utc', now()) END, leased_until = NULL
+	WHERE id = $1`
+	_, err := s.pool.Exec(ctx, q, id, lastErr, nextAttemptAt.UTC(), dead)
+	return err
+}
+
+func toMessage(event domain.Event) message {
+	m := message{
+		ID:            event.ID,
+		Type:          event.Type.String(),
+		OccurredAt:    event.OccurredAt,
+		ReviewerID:    event.ReviewerID,
+		OldReviewerID: event.OldReviewerID,
+		Verdict:       event.Verdict.String(),
+	}
+	if pr := event.PullRequest; pr != nil {
+		m.PullRequest = &pullRequest{
+			ID:                pr.ID,
+			Name:              pr.Name,
+			AuthorID:          pr.AuthorID,
+			Status:            pr.Status.String(),
+			AssignedReviewers: pr.AssignedReviewers,
+			NeedMoreReviewers: pr.NeedMoreReviewers,
+			CreatedAt:         pr.CreatedAt,
+			MergedAt:          pr.MergedAt,
+		}
+	}
+	return m
+}
+
+func toDomainEvent(m *message) domain.Event {
+	event := domain.Event{
+		ID:            m.ID,
+		Type:          domain.EventType(m.Type),
+		OccurredAt:    m.OccurredAt,
+		ReviewerID:    m.ReviewerID,
+		OldReviewerID: m.OldReviewerID,
+		Verdict:       domain.Verdict(m.Verdict),
+	}
+	if pr := m.PullRequest; pr != nil {
+		event.PullRequest = &domain.PullRequest{
+			ID:                pr.ID,
+			Name:              pr.Name,
+			AuthorID:          pr.AuthorID,
+			Status:            domain.Status(pr.Status),
+			AssignedReviewers: pr.AssignedReviewers,
+			NeedMoreReviewers: pr.NeedMoreReviewers,
+			CreatedAt:         pr.CreatedAt,
+			MergedAt:          pr.MergedAt,
+		}
+	}
+	return event
+}
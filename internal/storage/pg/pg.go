@@ -23,6 +23,7 @@ func NewStorage(ctx context.Context, log *slog.Logger, cfg *config.Config) (*Sto
 	if err != nil {
 		return nil, fmt.Errorf("failed parse config: %v", err)
 	}
+	pgCfg.ConnConfig.Tracer = newQueryTracer()
 
 	pool, err := pgxpool.NewWithConfig(ctx, pgCfg)
 	if err != nil {
@@ -2,12 +2,16 @@ package pull_request
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"log/slog"
 	"time"
 
 	"github.com/LeoUraltsev/PRReviewerService/internal/domain"
+	"github.com/LeoUraltsev/PRReviewerService/internal/storage/pg/outbox"
+	"github.com/LeoUraltsev/PRReviewerService/internal/storage/pg/user"
 	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
@@ -21,11 +25,30 @@ type pullRequest struct {
 	ID                string
 	Name              string
 	AuthorID          string
+	Description       string
+	Labels            []string
+	Priority          string
 	Status            string
 	AssignedReviewers []string
 	NeedMoreReviewers bool
 	CreatedAt         time.Time
 	MergedAt          *time.Time
+	RepositoryID      *string
+	ChangedFiles      []string
+	RequiredSkills    []string
+	MissingSkills     []string
+	ReviewDueAt       *time.Time
+	LinesAdded        *int
+	LinesRemoved      *int
+	FilesChanged      *int
+	ReviewersCount    int
+	Version           int64
+}
+
+// querier — общая часть пула и транзакции, нужная для чтения PR.
+type querier interface {
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
 }
 
 func NewStorage(log *slog.Logger, pool *pgxpool.Pool) *Storage {
@@ -35,7 +58,8 @@ func NewStorage(log *slog.Logger, pool *pgxpool.Pool) *Storage {
 	}
 }
 
-func (s *Storage) Save(ctx context.Context, pullRequest *domain.PullRequest) error {
+// Save сохраняет PR с ревьюверами и записывает events в outbox в той же транзакции.
+func (s *Storage) Save(ctx context.Context, pullRequest *domain.PullRequest, events []domain.Event) error {
 	tx, err := s.pool.Begin(ctx)
 	if err != nil {
 		return err
@@ -48,16 +72,45 @@ func (s *Storage) Save(ctx context.Context, pullRequest *domain.PullRequest) err
         name, 
         author_id, 
         status, 
-        need_more_reviewers
-    ) VALUES ($1, $2, $3, $4, $5)
+        need_more_reviewers,
+        repository_id,
+        changed_files,
+        required_skills,
+        missing_skills,
+        description,
+        labels,
+        priority,
+        review_due_at,
+        lines_added,
+        lines_removed,
+        files_changed,
+        reviewers_count
+    ) VALUES ($1, $2, $3, $4, $5, NULLIF($6, ''), $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)
     `
 
+	var linesAdded, linesRemoved, filesChanged *int
+	if size := pullRequest.Size; size != nil {
+		linesAdded, linesRemoved, filesChanged = &size.LinesAdded, &size.LinesRemoved, &size.FilesChanged
+	}
+
 	_, err = tx.Exec(ctx, q,
 		pullRequest.ID,
 		pullRequest.Name,
 		pullRequest.AuthorID,
 		pullRequest.Status,
 		pullRequest.NeedMoreReviewers,
+		pullRequest.RepositoryID,
+		nonNil(pullRequest.ChangedFiles),
+		nonNil(pullRequest.RequiredSkills),
+		nonNil(pullRequest.MissingSkills),
+		pullRequest.Description,
+		nonNil(pullRequest.Labels),
+		pullRequest.Priority,
+		pullRequest.ReviewDueAt,
+		linesAdded,
+		linesRemoved,
+		filesChanged,
+		pullRequest.ReviewersCount,
 	)
 
 	if err != nil {
@@ -65,11 +118,31 @@ func (s *Storage) Save(ctx context.Context, pullRequest *domain.PullRequest) err
 		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.UniqueViolation {
 			return domain.ErrPRAlreadyExists
 		}
+		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.ForeignKeyViolation {
+			return domain.ErrRepositoryNotFound
+		}
 		return err
 	}
 
+	// Ссылка на форж пишется в той же транзакции, чтобы relay outbox видел её вместе с событиями PR.
+	if ref := pullRequest.Forge; ref != nil {
+		q = `INSERT INTO forge_pull_requests (provider, repository, number, pr_id) VALUES ($1, $2, $3, $4)`
+		_, err = tx.Exec(ctx, q, ref.Provider.String(), ref.Repository, ref.Number, pullRequest.ID)
+		if err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.UniqueViolation {
+				return domain.ErrPRAlreadyExists
+			}
+			return err
+		}
+	}
+
 	// Затем добавляем ревьюверов
 	if len(pullRequest.AssignedReviewers) > 0 {
+		if err = user.LockCapacity(ctx, tx, pullRequest.AssignedReviewers); err != nil {
+			return err
+		}
+
 		q = `
         INSERT INTO reviewers (pr_id, user_id) 
         VALUES ($1, $2)
@@ -82,14 +155,16 @@ func (s *Storage) Save(ctx context.Context, pullRequest *domain.PullRequest) err
 			}
 		}
 	}
-	err = tx.Commit(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+
+	_, err = commitWithEvents(ctx, tx, pullRequest.ID, events)
+	return err
 }
 
 func (s *Storage) GetByID(ctx context.Context, prID string) (*domain.PullRequest, error) {
+	return getByID(ctx, s.pool, prID)
+}
+
+func getByID(ctx context.Context, db querier, prID string) (*domain.PullRequest, error) {
 	q := `SELECT 
     pr.id, 
     pr.name, 
@@ -98,14 +173,29 @@ func (s *Storage) GetByID(ctx context.Context, prID string) (*domain.PullRequest
     pr.need_more_reviewers, 
     pr.created_at, 
     pr.merged_at,
+    pr.repository_id,
+    pr.changed_files,
+    pr.required_skills,
+    pr.missing_skills,
+    pr.description,
+    pr.labels,
+    pr.priority,
+    pr.review_due_at,
+    pr.lines_added,
+    pr.lines_removed,
+    pr.files_changed,
+    pr.reviewers_count,
+    pr.version,
     COALESCE(array_agg(DISTINCT rv.user_id) FILTER (WHERE rv.user_id IS NOT NULL), ARRAY[]::text[]) AS reviewers
 FROM pull_requests pr
 LEFT JOIN reviewers rv ON rv.pr_id = pr.id 
 WHERE pr.id = $1
-GROUP BY pr.id, pr.name, pr.author_id, pr.status, pr.need_more_reviewers, pr.created_at, pr.merged_at
+GROUP BY pr.id, pr.name, pr.author_id, pr.status, pr.need_more_reviewers, pr.created_at, pr.merged_at,
+    pr.repository_id, pr.changed_files, pr.required_skills, pr.missing_skills, pr.description, pr.labels, pr.priority, pr.review_due_at,
+    pr.lines_added, pr.lines_removed, pr.files_changed, pr.reviewers_count, pr.version
 `
 	var pr pullRequest
-	err := s.pool.QueryRow(ctx, q, prID).Scan(
+	err := db.QueryRow(ctx, q, prID).Scan(
 		&pr.ID,
 		&pr.Name,
 		&pr.AuthorID,
@@ -113,49 +203,378 @@ GROUP BY pr.id, pr.name, pr.author_id, pr.status, pr.need_more_reviewers, pr.cre
 		&pr.NeedMoreReviewers,
 		&pr.CreatedAt,
 		&pr.MergedAt,
+		&pr.RepositoryID,
+		&pr.ChangedFiles,
+		&pr.RequiredSkills,
+		&pr.MissingSkills,
+		&pr.Description,
+		&pr.Labels,
+		&pr.Priority,
+		&pr.ReviewDueAt,
+		&pr.LinesAdded,
+		&pr.LinesRemoved,
+		&pr.FilesChanged,
+		&pr.ReviewersCount,
+		&pr.Version,
 		&pr.AssignedReviewers,
 	)
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrPRNotFound
+		}
 		return nil, err
 	}
 
-	return toDomainPullRequest(&pr), nil
+	res := toDomainPullRequest(&pr)
+	if res.Reviews, err = getReviews(ctx, db, prID); err != nil {
+		return nil, err
+	}
+	return res, nil
 }
 
-func (s *Storage) UpdateStatus(ctx context.Context, id string, status domain.Status) (*domain.PullRequest, error) {
+func getReviews(ctx context.Context, db querier, prID string) ([]domain.Review, error) {
+	q := `SELECT user_id, verdict, comment, assigned_at, submitted_at FROM reviewers WHERE pr_id = $1 ORDER BY assigned_at, user_id`
+	rows, err := db.Query(ctx, q, prID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	reviews := make([]domain.Review, 0)
+	for rows.Next() {
+		var (
+			r       domain.Review
+			verdict string
+		)
+		if err = rows.Scan(&r.ReviewerID, &verdict, &r.Comment, &r.AssignedAt, &r.SubmittedAt); err != nil {
+			return nil, err
+		}
+		r.Verdict = domain.Verdict(verdict)
+		reviews = append(reviews, r)
+	}
+	return reviews, rows.Err()
+}
+
+// UpdateStatus переводит PR из статуса from в status и в той же транзакции записывает события в outbox
+// и audit в журнал аудита. Допустимость перехода проверяет сервис, а здесь статус заблокированной строки
+// сверяется с from: если PR параллельно смержили, закрыли или открыли заново, возвращается ошибка
+// с кодом CodeInvalidTransition.
+func (s *Storage) UpdateStatus(ctx context.Context, id string, version int64, from domain.Status, status domain.Status, audit []domain.AuditEntry, events []domain.Event) (*domain.PullRequest, error) {
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(context.Background())
+
+	current, err := bumpVersion(ctx, tx, id, version)
+	if err != nil {
+		return nil, err
+	}
+	if current != from {
+		return nil, domain.NewTransitionError(current, status)
+	}
+
 	q := ""
 	if status == domain.Merged {
 		q = `UPDATE pull_requests SET status = $1, merged_at = timezone('utc', now()) WHERE id = $2`
 	} else {
 		q = `UPDATE pull_requests SET status = $1 WHERE id = $2`
 	}
-	_, err := s.pool.Exec(ctx, q, status, id)
+	_, err = tx.Exec(ctx, q, status, id)
 	if err != nil {
 		return nil, err
 	}
-	return s.GetByID(ctx, id)
+	if err = insertAudit(ctx, tx, id, audit); err != nil {
+		return nil, err
+	}
+
+	return commitWithEvents(ctx, tx, id, events)
 }
 
-func (s *Storage) Reassign(ctx context.Context, prID string, oldUserID string, newUserID string) (*domain.PullRequest, error) {
-	q := `update reviewers set user_id = $1 where pr_id = $2 and user_id = $3`
-	_, err := s.pool.Exec(ctx, q, newUserID, prID, oldUserID)
+// AssignReviewers переводит PR в статус pr.Status с ревьюверами pr.AssignedReviewers, например когда
+// черновик готов к ревью, сохраняет требуемые навыки, срок ревью и число ревьюверов по правилам
+// команды и в той же транзакции записывает события в outbox.
+func (s *Storage) AssignReviewers(ctx context.Context, pr *domain.PullRequest, version int64, events []domain.Event) (*domain.PullRequest, error) {
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(context.Background())
+
+	current, err := bumpVersion(ctx, tx, pr.ID, version)
 	if err != nil {
 		return nil, err
 	}
-	return s.GetByID(ctx, prID)
+	if !current.CanTransitionTo(pr.Status) {
+		return nil, domain.NewTransitionError(current, pr.Status)
+	}
+	if err = user.LockCapacity(ctx, tx, pr.AssignedReviewers); err != nil {
+		return nil, err
+	}
+
+	q := `UPDATE pull_requests
+	SET status = $2, need_more_reviewers = $3, required_skills = $4, missing_skills = $5, review_due_at = $6,
+	    reviewers_count = $7
+	WHERE id = $1`
+	_, err = tx.Exec(ctx, q, pr.ID, pr.Status, pr.NeedMoreReviewers, nonNil(pr.RequiredSkills), nonNil(pr.MissingSkills),
+		pr.ReviewDueAt, pr.ReviewersCount)
+	if err != nil {
+		return nil, err
+	}
+
+	q = `INSERT INTO reviewers (pr_id, user_id) VALUES ($1, $2)`
+	for _, reviewerID := range pr.AssignedReviewers {
+		if _, err = tx.Exec(ctx, q, pr.ID, reviewerID); err != nil {
+			return nil, err
+		}
+	}
+
+	return commitWithEvents(ctx, tx, pr.ID, events)
 }
 
-func (s *Storage) GetPRByUserID(ctx context.Context, userID string) ([]*domain.PullRequest, error) {
-	q := `SELECT pr_id FROM reviewers WHERE user_id = $1`
-	rows, err := s.pool.Query(ctx, q, userID)
+// ChangeReviewers снимает с PR ревьюверов removed, назначает added, сохраняет пересчитанные
+// pr.NeedMoreReviewers и pr.MissingSkills и в той же транзакции записывает audit в журнал аудита,
+// а события в outbox. Лимит открытых ревью added перепроверяется под блокировкой (см. user.LockCapacity).
+func (s *Storage) ChangeReviewers(ctx context.Context, pr *domain.PullRequest, version int64, added []string, removed []string, audit []domain.AuditEntry, events []domain.Event) (*domain.PullRequest, error) {
+	tx, err := s.pool.Begin(ctx)
 	if err != nil {
 		return nil, err
 	}
-	defer rows.Close()
-	var prs []*domain.PullRequest
-	for rows.Next() {
-		var id string
-		err = rows.Scan(&id)
+	defer tx.Rollback(context.Background())
+
+	if err = bumpOpenVersion(ctx, tx, pr.ID, version); err != nil {
+		return nil, err
+	}
+	if err = user.LockCapacity(ctx, tx, added); err != nil {
+		return nil, err
+	}
+
+	q := `DELETE FROM reviewers WHERE pr_id = $1 AND user_id = $2`
+	for _, userID := range removed {
+		tag, err := tx.Exec(ctx, q, pr.ID, userID)
+		if err != nil {
+			return nil, err
+		}
+		if tag.RowsAffected() == 0 {
+			return nil, domain.ErrNotAssigned
+		}
+	}
+
+	q = `INSERT INTO reviewers (pr_id, user_id) VALUES ($1, $2)`
+	for _, userID := range added {
+		if _, err = tx.Exec(ctx, q, pr.ID, userID); err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.UniqueViolation {
+				return nil, domain.ErrAlreadyAssigned
+			}
+			return nil, err
+		}
+	}
+
+	q = `UPDATE pull_requests SET need_more_reviewers = $2, missing_skills = $3 WHERE id = $1`
+	if _, err = tx.Exec(ctx, q, pr.ID, pr.NeedMoreReviewers, nonNil(pr.MissingSkills)); err != nil {
+		return nil, err
+	}
+	if err = insertAudit(ctx, tx, pr.ID, audit); err != nil {
+		return nil, err
+	}
+
+	return commitWithEvents(ctx, tx, pr.ID, events)
+}
+
+// UpdateMetadata сохраняет название, автора, описание, метки и приоритет PR вместе с пересчитанными
+// pr.NeedMoreReviewers и pr.MissingSkills, снимает ревьюверов removed и назначает added.
+// Автора и ревьюверов можно менять только у черновика или открытого PR: статус проверяется под блокировкой
+// строки, поэтому PR, смерженный или закрытый после чтения сервисом, не меняется (см. Status.CheckStaffable).
+// Если кого-то из removed уже сняли параллельным запросом, возвращается ErrNotAssigned.
+// События записываются в outbox в той же транзакции.
+func (s *Storage) UpdateMetadata(ctx context.Context, pr *domain.PullRequest, version int64, added []string, removed []string, events []domain.Event) (*domain.PullRequest, error) {
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(context.Background())
+
+	status, err := bumpVersion(ctx, tx, pr.ID, version)
+	if err != nil {
+		return nil, err
+	}
+	var authorID string
+	if err = tx.QueryRow(ctx, `SELECT author_id FROM pull_requests WHERE id = $1`, pr.ID).Scan(&authorID); err != nil {
+		return nil, err
+	}
+	if authorID != pr.AuthorID || len(added) > 0 || len(removed) > 0 {
+		if err = status.CheckStaffable(); err != nil {
+			return nil, err
+		}
+	}
+	if err = user.LockCapacity(ctx, tx, added); err != nil {
+		return nil, err
+	}
+
+	q := `UPDATE pull_requests
+	SET name = $2, author_id = $3, description = $4, labels = $5, priority = $6, need_more_reviewers = $7, missing_skills = $8
+	WHERE id = $1`
+	_, err = tx.Exec(ctx, q, pr.ID, pr.Name, pr.AuthorID, pr.Description, nonNil(pr.Labels), pr.Priority,
+		pr.NeedMoreReviewers, nonNil(pr.MissingSkills))
+	if err != nil {
+		return nil, err
+	}
+
+	q = `DELETE FROM reviewers WHERE pr_id = $1 AND user_id = $2`
+	for _, userID := range removed {
+		tag, err := tx.Exec(ctx, q, pr.ID, userID)
+		if err != nil {
+			return nil, err
+		}
+		if tag.RowsAffected() == 0 {
+			return nil, domain.ErrNotAssigned
+		}
+	}
+	q = `INSERT INTO reviewers (pr_id, user_id) VALUES ($1, $2)`
+	for _, userID := range added {
+		if _, err = tx.Exec(ctx, q, pr.ID, userID); err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.UniqueViolation {
+				return nil, domain.ErrAlreadyAssigned
+			}
+			return nil, err
+		}
+	}
+
+	return commitWithEvents(ctx, tx, pr.ID, events)
+}
+
+// Reassign передаёт ревью PR от oldUserID пользователю newUserID. Если oldUserID уже не ревьювер PR,
+// например его успели заменить параллельным запросом, возвращается ErrNotAssigned.
+// Если newUserID тем временем исчерпал лимит открытых ревью, возвращается ErrReviewerAtCapacity (см. user.LockCapacity).
+func (s *Storage) Reassign(ctx context.Context, prID string, version int64, oldUserID string, newUserID string, events []domain.Event) (*domain.PullRequest, error) {
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(context.Background())
+
+	if err = bumpOpenVersion(ctx, tx, prID, version); err != nil {
+		return nil, err
+	}
+	if err = user.LockCapacity(ctx, tx, []string{newUserID}); err != nil {
+		return nil, err
+	}
+
+	// Новый ревьювер начинает с чистого листа: вердикт прежнего к нему не переходит.
+	q := `update reviewers
+	set user_id = $1, verdict = 'PENDING', comment = '', assigned_at = timezone('utc', now()), submitted_at = NULL
+	where pr_id = $2 and user_id = $3`
+	tag, err := tx.Exec(ctx, q, newUserID, prID, oldUserID)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.UniqueViolation {
+			return nil, domain.ErrAlreadyAssigned
+		}
+		return nil, err
+	}
+	if tag.RowsAffected() == 0 {
+		return nil, domain.ErrNotAssigned
+	}
+
+	return commitWithEvents(ctx, tx, prID, events)
+}
+
+// SubmitReview записывает вердикт ревьювера по PR. Если ревьювер не назначен на PR, возвращается ErrNotAssigned,
+// если PR уже не открыт, например его смержили параллельно, — ErrPRNotOpen.
+func (s *Storage) SubmitReview(ctx context.Context, prID string, version int64, review *domain.Review, events []domain.Event) (*domain.PullRequest, error) {
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(context.Background())
+
+	if err = bumpOpenVersion(ctx, tx, prID, version); err != nil {
+		return nil, err
+	}
+
+	q := `UPDATE reviewers SET verdict = $3, comment = $4, submitted_at = timezone('utc', now())
+	WHERE pr_id = $1 AND user_id = $2`
+	tag, err := tx.Exec(ctx, q, prID, review.ReviewerID, review.Verdict.String(), review.Comment)
+	if err != nil {
+		return nil, err
+	}
+	if tag.RowsAffected() == 0 {
+		return nil, domain.ErrNotAssigned
+	}
+
+	return commitWithEvents(ctx, tx, prID, events)
+}
+
+// bumpVersion увеличивает версию PR и тем самым блокирует его строку до конца транзакции, так что
+// изменения одного PR выполняются по очереди. Если ожидаемая версия expected задана, она сравнивается
+// с версией до увеличения, пока строка заблокирована; при расхождении возвращается ErrVersionMismatch,
+// и вызывающий откатывает транзакцию.
+// Возвращается статус PR под блокировкой, по которому вызывающий проверяет, что изменение ещё допустимо.
+func bumpVersion(ctx context.Context, tx pgx.Tx, prID string, expected int64) (domain.Status, error) {
+	var (
+		version int64
+		status  string
+	)
+	q := `UPDATE pull_requests SET version = version + 1 WHERE id = $1 RETURNING version - 1, status`
+	if err := tx.QueryRow(ctx, q, prID).Scan(&version, &status); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", domain.ErrPRNotFound
+		}
+		return "", err
+	}
+	return domain.Status(status), domain.CheckVersion(expected, version)
+}
+
+// bumpOpenVersion — bumpVersion для изменений ревьюверов, допустимых только у открытого PR.
+func bumpOpenVersion(ctx context.Context, tx pgx.Tx, prID string, expected int64) error {
+	status, err := bumpVersion(ctx, tx, prID, expected)
+	if err != nil {
+		return err
+	}
+	if status != domain.Open {
+		return domain.ErrPRNotOpen
+	}
+	return nil
+}
+
+// commitWithEvents читает состояние PR после изменения внутри транзакции, прикладывает его
+// снимком к событиям, пишет их в outbox и фиксирует транзакцию.
+func commitWithEvents(ctx context.Context, tx pgx.Tx, prID string, events []domain.Event) (*domain.PullRequest, error) {
+	pr, err := getByID(ctx, tx, prID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range events {
+		events[i].PullRequest = pr
+	}
+	if err = outbox.Insert(ctx, tx, events); err != nil {
+		return nil, err
+	}
+	if err = tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return pr, nil
+}
+
+// GetPRByUserID возвращает PR, где userID назначен ревьювером и которые подходят под filter,
+// в порядке создания. Фильтр применяется в запросе, поэтому целиком загружаются только подходящие PR.
+func (s *Storage) GetPRByUserID(ctx context.Context, userID string, filter domain.PullRequestFilter) ([]*domain.PullRequest, error) {
+	q := `SELECT rv.pr_id FROM reviewers rv JOIN pull_requests pr ON pr.id = rv.pr_id
+	WHERE rv.user_id = $1 AND ($2 = '' OR $2 = ANY (pr.labels)) AND ($3 = '' OR pr.priority = $3)
+	ORDER BY pr.created_at, pr.id`
+	rows, err := s.pool.Query(ctx, q, userID, filter.Label, filter.Priority.String())
+	if err != nil {
+		return nil, err
+	}
+	ids, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return nil, err
+	}
+
+	prs := make([]*domain.PullRequest, 0, len(ids))
+	for _, id := range ids {
 		pr, err := s.GetByID(ctx, id)
 		if err != nil {
 			return nil, err
@@ -166,15 +585,110 @@ func (s *Storage) GetPRByUserID(ctx context.Context, userID string) ([]*domain.P
 }
 
 func toDomainPullRequest(pr *pullRequest) *domain.PullRequest {
+	repositoryID := ""
+	if pr.RepositoryID != nil {
+		repositoryID = *pr.RepositoryID
+	}
 
 	return &domain.PullRequest{
 		ID:                pr.ID,
 		Name:              pr.Name,
 		AuthorID:          pr.AuthorID,
+		Description:       pr.Description,
+		Labels:            pr.Labels,
+		Priority:          domain.Priority(pr.Priority),
 		Status:            domain.Status(pr.Status),
 		AssignedReviewers: pr.AssignedReviewers,
 		NeedMoreReviewers: pr.NeedMoreReviewers,
 		CreatedAt:         pr.CreatedAt,
 		MergedAt:          pr.MergedAt,
+		RepositoryID:      repositoryID,
+		ChangedFiles:      pr.ChangedFiles,
+		RequiredSkills:    pr.RequiredSkills,
+		MissingSkills:     pr.MissingSkills,
+		ReviewDueAt:       pr.ReviewDueAt,
+		Size:              toDomainSize(pr),
+		ReviewersCount:    pr.ReviewersCount,
+		Version:           pr.Version,
+	}
+}
+
+// toDomainSize собирает объём изменений PR; колонки размера пишутся только вместе,
+// поэтому NULL в lines_added означает, что объём неизвестен.
+func toDomainSize(pr *pullRequest) *domain.PullRequestSize {
+	if pr.LinesAdded == nil || pr.LinesRemoved == nil || pr.FilesChanged == nil {
+		return nil
+	}
+	return &domain.PullRequestSize{
+		LinesAdded:   *pr.LinesAdded,
+		LinesRemoved: *pr.LinesRemoved,
+		FilesChanged: *pr.FilesChanged,
+	}
+}
+
+// nonNil заменяет nil на пустой срез: колонки-массивы объявлены not null.
+func nonNil(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
+
+func insertAudit(ctx context.Context, tx pgx.Tx, prID string, entries []domain.AuditEntry) error {
+	q := `INSERT INTO audit_log (pr_id, action, actor, reason, details) VALUES ($1, $2, $3, $4, $5)`
+	for _, e := range entries {
+		details, err := json.Marshal(toAuditDetails(e.Details))
+		if err != nil {
+			return err
+		}
+		if _, err = tx.Exec(ctx, q, prID, string(e.Action), e.Actor, e.Reason, details); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type auditDetail struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+func toAuditDetails(details []domain.ErrorDetail) []auditDetail {
+	res := make([]auditDetail, len(details))
+	for i, d := range details {
+		res[i] = auditDetail{Field: d.Field, Message: d.Message}
+	}
+	return res
+}
+
+// GetAudit возвращает журнал аудита PR в порядке записи.
+func (s *Storage) GetAudit(ctx context.Context, prID string) ([]*domain.AuditEntry, error) {
+	q := `SELECT id, pr_id, action, actor, reason, details, created_at FROM audit_log WHERE pr_id = $1 ORDER BY id`
+	rows, err := s.pool.Query(ctx, q, prID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	entries := make([]*domain.AuditEntry, 0)
+	for rows.Next() {
+		var (
+			e       domain.AuditEntry
+			action  string
+			details []byte
+		)
+		if err = rows.Scan(&e.ID, &e.PullRequestID, &action, &e.Actor, &e.Reason, &details, &e.CreatedAt); err != nil {
+			return nil, err
+		}
+		var stored []auditDetail
+		if err = json.Unmarshal(details, &stored); err != nil {
+			return nil, err
+		}
+		for _, d := range stored {
+			e.Details = append(e.Details, domain.ErrorDetail{Field: d.Field, Message: d.Message})
+		}
+		e.Action = domain.AuditAction(action)
+		entries = append(entries, &e)
 	}
+	return entries, rows.Err()
 }
@@ -0,0 +1,467 @@
+package pull_request
+
+import (
+	"context"
+	"crypto/rand"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/LeoUraltsev/PRReviewerService/internal/domain"
+	"github.com/LeoUraltsev/PRReviewerService/internal/storage/pg/team"
+	"github.com/LeoUraltsev/PRReviewerService/internal/storage/pg/user"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testPool подключается к базе из TEST_POSTGRES_PR_CONNECTION_STRING с применёнными миграциями;
+// без переменной тест пропускается.
+func testPool(t *testing.T) *pgxpool.Pool {
+	t.Helper()
+	dsn := os.Getenv("TEST_POSTGRES_PR_CONNECTION_STRING")
+	if dsn == "" {
+		t.Skip("TEST_POSTGRES_PR_CONNECTION_STRING is not set")
+	}
+	pool, err := pgxpool.New(context.Background(), dsn)
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+	return pool
+}
+
+func TestStorage_UpdateStatus_ReleasesReviewerLoad(t *testing.T) {
+	pool := testPool(t)
+	ctx := context.Background()
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	prs, users, teams := NewStorage(log, pool), user.NewStorage(log, pool), team.NewStorage(log, pool)
+
+	suffix := strings.ToLower(rand.Text()[:8])
+	teamName := "load-" + suffix
+	author := &domain.User{UserID: "author-" + suffix, Username: "author", TeamName: teamName, IsActive: true}
+	reviewer := &domain.User{UserID: "reviewer-" + suffix, Username: "reviewer", TeamName: teamName, IsActive: true}
+
+	require.NoError(t, teams.Save(ctx, teamName))
+	t.Cleanup(func() {
+		_, _ = pool.Exec(context.Background(), `DELETE FROM pull_requests WHERE author_id = $1`, author.UserID)
+		_, _ = pool.Exec(context.Background(), `DELETE FROM teams WHERE name = $1`, teamName)
+	})
+	require.NoError(t, teams.SetMaxOpenReviews(ctx, teamName, 1))
+	require.NoError(t, users.SaveUsers(ctx, []*domain.User{author, reviewer}))
+
+	available := func() bool {
+		t.Helper()
+		candidates, err := users.GetInactiveUsers(ctx, teamName, 10, []string{author.UserID})
+		require.NoError(t, err)
+		return len(candidates) == 1
+	}
+	require.True(t, available(), "reviewer without open reviews is selectable")
+
+	for _, status := range []domain.Status{domain.Merged, domain.Closed} {
+		t.Run(status.String(), func(t *testing.T) {
+			prID := "pr-" + strings.ToLower(status.String()) + "-" + suffix
+			require.NoError(t, prs.Save(ctx, &domain.PullRequest{
+				ID:                prID,
+				Name:              "load",
+				AuthorID:          author.UserID,
+				Status:            domain.Open,
+				AssignedReviewers: []string{reviewer.UserID},
+			}, nil))
+			assert.False(t, available(), "reviewer at the limit must not be selectable")
+
+			pr, err := prs.UpdateStatus(ctx, prID, 0, domain.Open, status, nil, nil)
+			require.NoError(t, err)
+			assert.Equal(t, status, pr.Status)
+			assert.Equal(t, []string{reviewer.UserID}, pr.AssignedReviewers, "history of reviewers is kept")
+			assert.True(t, available(), "load must be released together with the status change")
+
+			if status == domain.Closed {
+				_, err = prs.UpdateStatus(ctx, prID, 0, domain.Closed, domain.Open, nil, nil)
+				require.NoError(t, err)
+				assert.False(t, available(), "reopened PR counts towards the load again")
+				_, err = prs.UpdateStatus(ctx, prID, 0, domain.Open, domain.Closed, nil, nil)
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestStorage_RechecksReviewerCapacity(t *testing.T) {
+	pool := testPool(t)
+	ctx := context.Background()
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	prs, users, teams := NewStorage(log, pool), user.NewStorage(log, pool), team.NewStorage(log, pool)
+
+	suffix := strings.ToLower(rand.Text()[:8])
+	teamName := "capacity-" + suffix
+	author := &domain.User{UserID: "author-" + suffix, Username: "author", TeamName: teamName, IsActive: true}
+	reviewer := &domain.User{UserID: "reviewer-" + suffix, Username: "reviewer", TeamName: teamName, IsActive: true}
+	other := &domain.User{UserID: "other-" + suffix, Username: "other", TeamName: teamName, IsActive: true}
+
+	require.NoError(t, teams.Save(ctx, teamName))
+	t.Cleanup(func() {
+		_, _ = pool.Exec(context.Background(), `DELETE FROM pull_requests WHERE author_id = $1`, author.UserID)
+		_, _ = pool.Exec(context.Background(), `DELETE FROM teams WHERE name = $1`, teamName)
+	})
+	require.NoError(t, teams.SetMaxOpenReviews(ctx, teamName, 1))
+	require.NoError(t, users.SaveUsers(ctx, []*domain.User{author, reviewer, other}))
+
+	var prIDs []string
+	for _, name := range []string{"first", "second"} {
+		prID := "pr-" + name + "-" + suffix
+		require.NoError(t, prs.Save(ctx, &domain.PullRequest{ID: prID, Name: name, AuthorID: author.UserID, Status: domain.Open}, nil))
+		prIDs = append(prIDs, prID)
+	}
+
+	// Сервис увидел у ревьювера свободное место для обоих PR, и назначения идут параллельно.
+	var (
+		wg   sync.WaitGroup
+		errs = make([]error, len(prIDs))
+	)
+	for i, prID := range prIDs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			pr := &domain.PullRequest{ID: prID, AssignedReviewers: []string{reviewer.UserID}}
+			_, errs[i] = prs.ChangeReviewers(ctx, pr, 0, []string{reviewer.UserID}, nil, nil, nil)
+		}()
+	}
+	wg.Wait()
+
+	var rejected int
+	for _, err := range errs {
+		if err != nil {
+			assert.ErrorIs(t, err, domain.ErrReviewerAtCapacity)
+			rejected++
+		}
+	}
+	assert.Equal(t, 1, rejected, "only one assignment fits into the limit")
+
+	capacity, err := users.GetCapacity(ctx, reviewer.UserID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, capacity.OpenReviews)
+
+	// Замена на ревьювера без свободного места тоже отклоняется под блокировкой.
+	free := prIDs[0]
+	if errs[0] == nil {
+		free = prIDs[1]
+	}
+	_, err = prs.ChangeReviewers(ctx, &domain.PullRequest{ID: free}, 0, []string{other.UserID}, nil, nil, nil)
+	require.NoError(t, err)
+	_, err = prs.Reassign(ctx, free, 0, other.UserID, reviewer.UserID, nil)
+	assert.ErrorIs(t, err, domain.ErrReviewerAtCapacity)
+}
+
+// TestMigration_RestoresReviewersDeactivatedByAssignment проверяет миграцию, которая возвращает в активные
+// ревьюверов, деактивированных старым назначением. До 00018 у всех пользователей версия 1, поэтому
+// и ошибочная, и намеренная деактивация выглядят одинаково: восстанавливаются только явно перечисленные.
+func TestMigration_RestoresReviewersDeactivatedByAssignment(t *testing.T) {
+	pool := testPool(t)
+	ctx := context.Background()
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	prs, users, teams := NewStorage(log, pool), user.NewStorage(log, pool), team.NewStorage(log, pool)
+
+	suffix := strings.ToLower(rand.Text()[:8])
+	teamName := "restore-" + suffix
+	var members []*domain.User
+	for _, name := range []string{"author", "assigned", "intended"} {
+		members = append(members, &domain.User{UserID: name + "-" + suffix, Username: name, TeamName: teamName, IsActive: true})
+	}
+	author, assigned, intended := members[0].UserID, members[1].UserID, members[2].UserID
+
+	require.NoError(t, teams.Save(ctx, teamName))
+	t.Cleanup(func() {
+		_, _ = pool.Exec(context.Background(), `DELETE FROM pull_requests WHERE author_id = $1`, author)
+		_, _ = pool.Exec(context.Background(), `DELETE FROM teams WHERE name = $1`, teamName)
+	})
+	require.NoError(t, users.SaveUsers(ctx, members))
+	require.NoError(t, prs.Save(ctx, &domain.PullRequest{
+		ID:                "pr-restore-" + suffix,
+		Name:              "restore",
+		AuthorID:          author,
+		Status:            domain.Merged,
+		AssignedReviewers: []string{assigned, intended},
+	}, nil))
+
+	// Данные до 00018: обоих ревьюверов деактивировали без смены версии — одного старым назначением,
+	// другого намеренно, через API того времени.
+	_, err := pool.Exec(ctx, `UPDATE users SET is_active = false, version = 1 WHERE id = ANY($1)`, []string{assigned, intended})
+	require.NoError(t, err)
+
+	migrate := func(restoreIDs string) {
+		t.Helper()
+		tx, err := pool.Begin(ctx)
+		require.NoError(t, err)
+		defer tx.Rollback(context.Background())
+		_, err = tx.Exec(ctx, `SELECT set_config('pr_reviewer.restore_user_ids', $1, true)`, restoreIDs)
+		require.NoError(t, err)
+		_, err = tx.Exec(ctx, migrationUp(t, "00021_restore_reviewers.sql"))
+		require.NoError(t, err)
+		require.NoError(t, tx.Commit(ctx))
+	}
+	isActive := func(id string) bool {
+		t.Helper()
+		u, err := users.GetByID(ctx, id)
+		require.NoError(t, err)
+		return u.IsActive
+	}
+
+	migrate("")
+	assert.False(t, isActive(assigned), "without an explicit list nobody is restored")
+	assert.False(t, isActive(intended))
+
+	migrate(" " + assigned + " ")
+	assert.True(t, isActive(assigned))
+	assert.False(t, isActive(intended), "intentional deactivation must be kept")
+}
+
+// migrationUp возвращает SQL секции Up миграции goose из каталога migrations.
+func migrationUp(t *testing.T, name string) string {
+	t.Helper()
+	content, err := os.ReadFile(filepath.Join("..", "..", "..", "..", "migrations", name))
+	require.NoError(t, err)
+	up, _, _ := strings.Cut(string(content), "-- +goose Down")
+	_, up, ok := strings.Cut(up, "-- +goose StatementBegin")
+	require.True(t, ok)
+	up, _, _ = strings.Cut(up, "-- +goose StatementEnd")
+	return up
+}
+
+func TestStorage_Reassign_ChecksVersion(t *testing.T) {
+	pool := testPool(t)
+	ctx := context.Background()
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	prs, users, teams := NewStorage(log, pool), user.NewStorage(log, pool), team.NewStorage(log, pool)
+
+	suffix := strings.ToLower(rand.Text()[:8])
+	teamName := "version-" + suffix
+	var members []*domain.User
+	for _, name := range []string{"author", "first", "second", "third"} {
+		members = append(members, &domain.User{UserID: name + "-" + suffix, Username: name, TeamName: teamName, IsActive: true})
+	}
+	author, first, second, third := members[0].UserID, members[1].UserID, members[2].UserID, members[3].UserID
+
+	require.NoError(t, teams.Save(ctx, teamName))
+	t.Cleanup(func() {
+		_, _ = pool.Exec(context.Background(), `DELETE FROM pull_requests WHERE author_id = $1`, author)
+		_, _ = pool.Exec(context.Background(), `DELETE FROM teams WHERE name = $1`, teamName)
+	})
+	require.NoError(t, users.SaveUsers(ctx, members))
+
+	prID := "pr-version-" + suffix
+	require.NoError(t, prs.Save(ctx, &domain.PullRequest{
+		ID:                prID,
+		Name:              "version",
+		AuthorID:          author,
+		Status:            domain.Open,
+		AssignedReviewers: []string{first},
+	}, nil))
+
+	// Два администратора прочитали PR в версии 1 и заменяют одного и того же ревьювера.
+	pr, err := prs.Reassign(ctx, prID, 1, first, second, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), pr.Version)
+
+	_, err = prs.Reassign(ctx, prID, 1, first, third, nil)
+	assert.ErrorIs(t, err, domain.ErrVersionMismatch)
+	_, err = prs.Reassign(ctx, prID, 0, first, third, nil)
+	assert.ErrorIs(t, err, domain.ErrNotAssigned)
+
+	pr, err = prs.GetByID(ctx, prID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{second}, pr.AssignedReviewers)
+	assert.Equal(t, int64(2), pr.Version, "rejected changes must not bump the version")
+}
+
+func TestStorage_RejectsChangesOfMergedPR(t *testing.T) {
+	pool := testPool(t)
+	ctx := context.Background()
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	prs, users, teams := NewStorage(log, pool), user.NewStorage(log, pool), team.NewStorage(log, pool)
+
+	suffix := strings.ToLower(rand.Text()[:8])
+	teamName := "stale-" + suffix
+	author := &domain.User{UserID: "author-" + suffix, Username: "author", TeamName: teamName, IsActive: true}
+	reviewer := &domain.User{UserID: "reviewer-" + suffix, Username: "reviewer", TeamName: teamName, IsActive: true}
+
+	require.NoError(t, teams.Save(ctx, teamName))
+	t.Cleanup(func() {
+		_, _ = pool.Exec(context.Background(), `DELETE FROM pull_requests WHERE author_id = $1`, author.UserID)
+		_, _ = pool.Exec(context.Background(), `DELETE FROM teams WHERE name = $1`, teamName)
+	})
+	require.NoError(t, users.SaveUsers(ctx, []*domain.User{author, reviewer}))
+
+	prID := "pr-stale-" + suffix
+	require.NoError(t, prs.Save(ctx, &domain.PullRequest{
+		ID:                prID,
+		Name:              "stale",
+		AuthorID:          author.UserID,
+		Status:            domain.Open,
+		AssignedReviewers: []string{reviewer.UserID},
+	}, nil))
+
+	// Сервис прочитал открытый PR, но до записи его смержили параллельным запросом.
+	_, err := prs.UpdateStatus(ctx, prID, 0, domain.Open, domain.Merged, nil, nil)
+	require.NoError(t, err)
+
+	_, err = prs.UpdateStatus(ctx, prID, 0, domain.Open, domain.Closed, nil, nil)
+	var domainErr *domain.Error
+	require.ErrorAs(t, err, &domainErr)
+	assert.Equal(t, domain.CodeInvalidTransition, domainErr.Code)
+
+	_, err = prs.SubmitReview(ctx, prID, 0, &domain.Review{ReviewerID: reviewer.UserID, Verdict: domain.VerdictApproved}, nil)
+	assert.ErrorIs(t, err, domain.ErrPRNotOpen)
+
+	// Смена автора, который был ревьювером, снимает его с ревью — у смерженного PR это недопустимо.
+	_, err = prs.UpdateMetadata(ctx, &domain.PullRequest{ID: prID, Name: "stale", AuthorID: reviewer.UserID}, 0,
+		nil, []string{reviewer.UserID}, nil)
+	assert.ErrorIs(t, err, domain.ErrPRMerged)
+
+	pr, err := prs.GetByID(ctx, prID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.Merged, pr.Status)
+	assert.Equal(t, author.UserID, pr.AuthorID)
+	assert.Equal(t, []string{reviewer.UserID}, pr.AssignedReviewers)
+	assert.Equal(t, domain.VerdictPending, pr.Reviews[0].Verdict)
+	assert.Equal(t, int64(2), pr.Version, "rejected changes must not bump the version")
+}
+
+func TestStorage_UpdateMetadata_RejectsRemovedReviewer(t *testing.T) {
+	pool := testPool(t)
+	ctx := context.Background()
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	prs, users, teams := NewStorage(log, pool), user.NewStorage(log, pool), team.NewStorage(log, pool)
+
+	suffix := strings.ToLower(rand.Text()[:8])
+	teamName := "meta-" + suffix
+	author := &domain.User{UserID: "author-" + suffix, Username: "author", TeamName: teamName, IsActive: true}
+	reviewer := &domain.User{UserID: "reviewer-" + suffix, Username: "reviewer", TeamName: teamName, IsActive: true}
+
+	require.NoError(t, teams.Save(ctx, teamName))
+	t.Cleanup(func() {
+		_, _ = pool.Exec(context.Background(), `DELETE FROM pull_requests WHERE author_id IN ($1, $2)`, author.UserID, reviewer.UserID)
+		_, _ = pool.Exec(context.Background(), `DELETE FROM teams WHERE name = $1`, teamName)
+	})
+	require.NoError(t, users.SaveUsers(ctx, []*domain.User{author, reviewer}))
+
+	prID := "pr-meta-" + suffix
+	pr := &domain.PullRequest{ID: prID, Name: "meta", AuthorID: author.UserID, Status: domain.Open}
+	require.NoError(t, prs.Save(ctx, pr, nil))
+
+	// Новый автор уже не ревьювер: его успели снять параллельным запросом.
+	pr.AuthorID = reviewer.UserID
+	_, err := prs.UpdateMetadata(ctx, pr, 0, nil, []string{reviewer.UserID}, nil)
+	assert.ErrorIs(t, err, domain.ErrNotAssigned)
+
+	stored, err := prs.GetByID(ctx, prID)
+	require.NoError(t, err)
+	assert.Equal(t, author.UserID, stored.AuthorID)
+}
+
+func TestStorage_SubmitReview(t *testing.T) {
+	pool := testPool(t)
+	ctx := context.Background()
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	prs, users, teams := NewStorage(log, pool), user.NewStorage(log, pool), team.NewStorage(log, pool)
+
+	suffix := strings.ToLower(rand.Text()[:8])
+	teamName := "verdict-" + suffix
+	author := &domain.User{UserID: "author-" + suffix, Username: "author", TeamName: teamName, IsActive: true}
+	reviewer := &domain.User{UserID: "reviewer-" + suffix, Username: "reviewer", TeamName: teamName, IsActive: true}
+
+	require.NoError(t, teams.Save(ctx, teamName))
+	t.Cleanup(func() {
+		_, _ = pool.Exec(context.Background(), `DELETE FROM pull_requests WHERE author_id = $1`, author.UserID)
+		_, _ = pool.Exec(context.Background(), `DELETE FROM teams WHERE name = $1`, teamName)
+	})
+	require.NoError(t, users.SaveUsers(ctx, []*domain.User{author, reviewer}))
+
+	prID := "pr-verdict-" + suffix
+	require.NoError(t, prs.Save(ctx, &domain.PullRequest{
+		ID:                prID,
+		Name:              "verdict",
+		AuthorID:          author.UserID,
+		Status:            domain.Open,
+		AssignedReviewers: []string{reviewer.UserID},
+	}, nil))
+
+	submit := func(verdict domain.Verdict, comment string) *domain.PullRequest {
+		t.Helper()
+		event := domain.NewEvent(domain.EventReviewSubmitted)
+		event.ReviewerID = reviewer.UserID
+		event.Verdict = verdict
+		pr, err := prs.SubmitReview(ctx, prID, 0, &domain.Review{ReviewerID: reviewer.UserID, Verdict: verdict, Comment: comment}, []domain.Event{event})
+		require.NoError(t, err)
+
+		var stored string
+		require.NoError(t, pool.QueryRow(ctx, `SELECT payload ->> 'verdict' FROM outbox WHERE event_id = $1`, event.ID).Scan(&stored))
+		assert.Equal(t, verdict.String(), stored, "verdict must reach the outbox payload")
+		return pr
+	}
+
+	pr := submit(domain.VerdictChangesRequested, "needs tests")
+	require.Len(t, pr.Reviews, 1)
+	assert.Equal(t, domain.VerdictChangesRequested, pr.Reviews[0].Verdict)
+	assert.Equal(t, "needs tests", pr.Reviews[0].Comment)
+	assert.NotNil(t, pr.Reviews[0].SubmittedAt)
+
+	// Повторный вердикт заменяет предыдущий.
+	pr = submit(domain.VerdictApproved, "")
+	require.Len(t, pr.Reviews, 1)
+	assert.Equal(t, domain.VerdictApproved, pr.Reviews[0].Verdict)
+	assert.Empty(t, pr.Reviews[0].Comment)
+
+	// /users/getReview строится по GetPRByUserID и показывает вердикт ревьювера.
+	reviews, err := prs.GetPRByUserID(ctx, reviewer.UserID, domain.PullRequestFilter{})
+	require.NoError(t, err)
+	require.Len(t, reviews, 1)
+	require.Len(t, reviews[0].Reviews, 1)
+	assert.Equal(t, domain.VerdictApproved, reviews[0].Reviews[0].Verdict)
+}
+
+func TestStorage_GetPRByUserID_Filter(t *testing.T) {
+	pool := testPool(t)
+	ctx := context.Background()
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	prs, users, teams := NewStorage(log, pool), user.NewStorage(log, pool), team.NewStorage(log, pool)
+
+	suffix := strings.ToLower(rand.Text()[:8])
+	teamName := "filter-" + suffix
+	author := &domain.User{UserID: "author-" + suffix, Username: "author", TeamName: teamName, IsActive: true}
+	reviewer := &domain.User{UserID: "reviewer-" + suffix, Username: "reviewer", TeamName: teamName, IsActive: true}
+
+	require.NoError(t, teams.Save(ctx, teamName))
+	t.Cleanup(func() {
+		_, _ = pool.Exec(context.Background(), `DELETE FROM pull_requests WHERE author_id = $1`, author.UserID)
+		_, _ = pool.Exec(context.Background(), `DELETE FROM teams WHERE name = $1`, teamName)
+	})
+	require.NoError(t, users.SaveUsers(ctx, []*domain.User{author, reviewer}))
+
+	for _, pr := range []*domain.PullRequest{
+		{ID: "pr-hotfix-" + suffix, Labels: []string{"backend", "hotfix"}, Priority: domain.PriorityHigh},
+		{ID: "pr-docs-" + suffix, Labels: []string{"docs"}, Priority: domain.PriorityLow},
+		{ID: "pr-urgent-" + suffix, Labels: []string{"hotfix"}, Priority: domain.PriorityUrgent},
+	} {
+		pr.Name, pr.AuthorID, pr.Status, pr.AssignedReviewers = "filter", author.UserID, domain.Open, []string{reviewer.UserID}
+		require.NoError(t, prs.Save(ctx, pr, nil))
+	}
+
+	ids := func(filter domain.PullRequestFilter) []string {
+		t.Helper()
+		res, err := prs.GetPRByUserID(ctx, reviewer.UserID, filter)
+		require.NoError(t, err)
+		var ids []string
+		for _, pr := range res {
+			ids = append(ids, strings.TrimSuffix(pr.ID, "-"+suffix))
+		}
+		return ids
+	}
+	assert.Equal(t, []string{"pr-hotfix", "pr-docs", "pr-urgent"}, ids(domain.PullRequestFilter{}))
+	assert.Equal(t, []string{"pr-hotfix", "pr-urgent"}, ids(domain.PullRequestFilter{Label: "hotfix"}))
+	assert.Equal(t, []string{"pr-docs"}, ids(domain.PullRequestFilter{Priority: domain.PriorityLow}))
+	assert.Equal(t, []string{"pr-urgent"}, ids(domain.PullRequestFilter{Label: "hotfix", Priority: domain.PriorityUrgent}))
+	assert.Empty(t, ids(domain.PullRequestFilter{Label: "frontend"}))
+}
@@ -0,0 +1,247 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/LeoUraltsev/PRReviewerService/internal/domain"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type Storage struct {
+	log  *slog.Logger
+	pool *pgxpool.Pool
+}
+
+type repository struct {
+	ID                 string
+	Name               string
+	ReviewersCount     int
+	AuthorTeamFallback bool
+	MergePolicy        domain.MergePolicy
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+	OwnerTeams         []string
+	Reviewers          []string
+}
+
+func NewStorage(log *slog.Logger, pool *pgxpool.Pool) *Storage {
+	return &Storage{
+		log:  log,
+		pool: pool,
+	}
+}
+
+// Create сохраняет репозиторий вместе с командами-владельцами и пулом ревьюверов.
+func (s *Storage) Create(ctx context.Context, repo *domain.Repository) (*domain.Repository, error) {
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(context.Background())
+
+	policy := repo.Settings.MergePolicy
+	q := `INSERT INTO repositories (
+	    id, name, reviewers_count, author_team_fallback,
+	    merge_required_approvals, merge_block_on_changes_requested, merge_require_full_staff
+	) VALUES ($1, $2, $3, $4, $5, $6, $7)`
+	_, err = tx.Exec(ctx, q, repo.ID, repo.Name, repo.Settings.ReviewersCount, repo.Settings.AuthorTeamFallback,
+		policy.RequiredApprovals, policy.BlockOnChangesRequested, policy.RequireFullStaff)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.UniqueViolation {
+			return nil, domain.ErrRepositoryExists
+		}
+		return nil, err
+	}
+
+	if err = savePool(ctx, tx, repo); err != nil {
+		return nil, err
+	}
+	if err = tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return s.GetRepository(ctx, repo.ID)
+}
+
+// Update заменяет название, настройки, команды-владельцы и пул ревьюверов репозитория.
+func (s *Storage) Update(ctx context.Context, repo *domain.Repository) (*domain.Repository, error) {
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(context.Background())
+
+	policy := repo.Settings.MergePolicy
+	q := `UPDATE repositories SET name = $2, reviewers_count = $3, author_team_fallback = $4,
+	merge_required_approvals = $5, merge_block_on_changes_requested = $6, merge_require_full_staff = $7,
+	updated_at = timezone('utc', now()) WHERE id = $1`
+	tag, err := tx.Exec(ctx, q, repo.ID, repo.Name, repo.Settings.ReviewersCount, repo.Settings.AuthorTeamFallback,
+		policy.RequiredApprovals, policy.BlockOnChangesRequested, policy.RequireFullStaff)
+	if err != nil {
+		return nil, err
+	}
+	if tag.RowsAffected() == 0 {
+		return nil, domain.ErrRepositoryNotFound
+	}
+
+	if _, err = tx.Exec(ctx, `DELETE FROM repository_teams WHERE repository_id = $1`, repo.ID); err != nil {
+		return nil, err
+	}
+	if _, err = tx.Exec(ctx, `DELETE FROM repository_reviewers WHERE repository_id = $1`, repo.ID); err != nil {
+		return nil, err
+	}
+	if err = savePool(ctx, tx, repo); err != nil {
+		return nil, err
+	}
+	if err = tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return s.GetRepository(ctx, repo.ID)
+}
+
+func savePool(ctx context.Context, tx pgx.Tx, repo *domain.Repository) error {
+	for _, team := range repo.OwnerTeams {
+		_, err := tx.Exec(ctx, `INSERT INTO repository_teams (repository_id, team_name) VALUES ($1, $2) ON CONFLICT DO NOTHING`, repo.ID, team)
+		if err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.ForeignKeyViolation {
+				return domain.ErrTeamNotFound
+			}
+			return err
+		}
+	}
+	for _, userID := range repo.Reviewers {
+		_, err := tx.Exec(ctx, `INSERT INTO repository_reviewers (repository_id, user_id) VALUES ($1, $2) ON CONFLICT DO NOTHING`, repo.ID, userID)
+		if err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.ForeignKeyViolation {
+				return domain.ErrUserNotFound
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+const selectRepository = `SELECT
+    r.id,
+    r.name,
+    r.reviewers_count,
+    r.author_team_fallback,
+    r.merge_required_approvals,
+    r.merge_block_on_changes_requested,
+    r.merge_require_full_staff,
+    r.created_at,
+    r.updated_at,
+    COALESCE((SELECT array_agg(team_name ORDER BY team_name) FROM repository_teams WHERE repository_id = r.id), ARRAY[]::text[]),
+    COALESCE((SELECT array_agg(user_id ORDER BY user_id) FROM repository_reviewers WHERE repository_id = r.id), ARRAY[]::text[])
+FROM repositories r`
+
+func (s *Storage) GetRepository(ctx context.Context, repositoryID string) (*domain.Repository, error) {
+	var r repository
+	err := scanRepository(s.pool.QueryRow(ctx, selectRepository+` WHERE r.id = $1`, repositoryID), &r)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrRepositoryNotFound
+		}
+		return nil, err
+	}
+	return toDomainRepository(&r), nil
+}
+
+func (s *Storage) ListRepositories(ctx context.Context) ([]*domain.Repository, error) {
+	rows, err := s.pool.Query(ctx, selectRepository+` ORDER BY r.id`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	repos := make([]*domain.Repository, 0)
+	for rows.Next() {
+		var r repository
+		if err = scanRepository(rows, &r); err != nil {
+			return nil, err
+		}
+		repos = append(repos, toDomainRepository(&r))
+	}
+	return repos, rows.Err()
+}
+
+// Delete удаляет репозиторий с его пулом и CODEOWNERS; у PR репозитория ссылка обнуляется.
+func (s *Storage) Delete(ctx context.Context, repositoryID string) error {
+	tag, err := s.pool.Exec(ctx, `DELETE FROM repositories WHERE id = $1`, repositoryID)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrRepositoryNotFound
+	}
+	return nil
+}
+
+func (s *Storage) SetCodeOwners(ctx context.Context, repositoryID string, content string) (*domain.CodeOwnersFile, error) {
+	q := `INSERT INTO codeowners (repository_id, content) VALUES ($1, $2)
+	ON CONFLICT (repository_id) DO UPDATE SET content = excluded.content, updated_at = timezone('utc', now())
+	RETURNING repository_id, content, updated_at`
+	var f domain.CodeOwnersFile
+	err := s.pool.QueryRow(ctx, q, repositoryID, content).Scan(&f.RepositoryID, &f.Content, &f.UpdatedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.ForeignKeyViolation {
+			return nil, domain.ErrRepositoryNotFound
+		}
+		return nil, err
+	}
+	return &f, nil
+}
+
+func (s *Storage) GetCodeOwners(ctx context.Context, repositoryID string) (*domain.CodeOwnersFile, error) {
+	q := `SELECT repository_id, content, updated_at FROM codeowners WHERE repository_id = $1`
+	var f domain.CodeOwnersFile
+	err := s.pool.QueryRow(ctx, q, repositoryID).Scan(&f.RepositoryID, &f.Content, &f.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrCodeOwnersNotFound
+		}
+		return nil, err
+	}
+	return &f, nil
+}
+
+func scanRepository(row pgx.Row, r *repository) error {
+	return row.Scan(
+		&r.ID,
+		&r.Name,
+		&r.ReviewersCount,
+		&r.AuthorTeamFallback,
+		&r.MergePolicy.RequiredApprovals,
+		&r.MergePolicy.BlockOnChangesRequested,
+		&r.MergePolicy.RequireFullStaff,
+		&r.CreatedAt,
+		&r.UpdatedAt,
+		&r.OwnerTeams,
+		&r.Reviewers,
+	)
+}
+
+func toDomainRepository(r *repository) *domain.Repository {
+	return &domain.Repository{
+		ID:         r.ID,
+		Name:       r.Name,
+		OwnerTeams: r.OwnerTeams,
+		Reviewers:  r.Reviewers,
+		Settings: domain.RepositorySettings{
+			ReviewersCount:     r.ReviewersCount,
+			AuthorTeamFallback: r.AuthorTeamFallback,
+			MergePolicy:        r.MergePolicy,
+		},
+		CreatedAt: r.CreatedAt,
+		UpdatedAt: r.UpdatedAt,
+	}
+}
@@ -2,8 +2,10 @@ package team
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"log/slog"
+	"time"
 
 	"github.com/LeoUraltsev/PRReviewerService/internal/domain"
 	"github.com/jackc/pgerrcode"
@@ -48,3 +50,132 @@ func (s *Storage) CheckExistsTeam(ctx context.Context, teamName string) (bool, e
 	}
 	return count > 0, nil
 }
+
+// SetMaxOpenReviews задаёт лимит открытых ревью по умолчанию для участников команды.
+func (s *Storage) SetMaxOpenReviews(ctx context.Context, teamName string, limit int) error {
+	tag, err := s.pool.Exec(ctx, `UPDATE teams SET max_open_reviews = $2 WHERE name = $1`, teamName, limit)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrTeamNotFound
+	}
+	return nil
+}
+
+func (s *Storage) GetMaxOpenReviews(ctx context.Context, teamName string) (int, error) {
+	var limit int
+	err := s.pool.QueryRow(ctx, `SELECT max_open_reviews FROM teams WHERE name = $1`, teamName).Scan(&limit)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, domain.ErrTeamNotFound
+		}
+		return 0, err
+	}
+	return limit, nil
+}
+
+// SetWorkingHoursPolicy задаёт, как при выборе ревьюверов учитываются рабочие часы участников команды.
+func (s *Storage) SetWorkingHoursPolicy(ctx context.Context, teamName string, policy domain.WorkingHoursPolicy) error {
+	tag, err := s.pool.Exec(ctx, `UPDATE teams SET working_hours_policy = $2 WHERE name = $1`, teamName, string(policy))
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrTeamNotFound
+	}
+	return nil
+}
+
+// SetMergePolicy задаёт условия merge PR, авторы которых состоят в команде.
+func (s *Storage) SetMergePolicy(ctx context.Context, teamName string, policy domain.MergePolicy) error {
+	q := `UPDATE teams SET merge_required_approvals = $2, merge_block_on_changes_requested = $3, merge_require_full_staff = $4
+	WHERE name = $1`
+	tag, err := s.pool.Exec(ctx, q, teamName, policy.RequiredApprovals, policy.BlockOnChangesRequested, policy.RequireFullStaff)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrTeamNotFound
+	}
+	return nil
+}
+
+func (s *Storage) GetMergePolicy(ctx context.Context, teamName string) (domain.MergePolicy, error) {
+	q := `SELECT merge_required_approvals, merge_block_on_changes_requested, merge_require_full_staff FROM teams WHERE name = $1`
+	var policy domain.MergePolicy
+	err := s.pool.QueryRow(ctx, q, teamName).Scan(&policy.RequiredApprovals, &policy.BlockOnChangesRequested, &policy.RequireFullStaff)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.MergePolicy{}, domain.ErrTeamNotFound
+		}
+		return domain.MergePolicy{}, err
+	}
+	return policy, nil
+}
+
+// reviewRule — правило ревью в колонке teams.review_rules.
+type reviewRule struct {
+	Label          string   `json:"label,omitempty"`
+	Priority       string   `json:"priority,omitempty"`
+	Size           string   `json:"size,omitempty"`
+	RequiredSkills []string `json:"required_skills,omitempty"`
+	SLAMinutes     int      `json:"sla_minutes,omitempty"`
+	ReviewersCount int      `json:"reviewers_count,omitempty"`
+}
+
+// SetReviewRules заменяет правила ревью PR, авторы которых состоят в команде.
+func (s *Storage) SetReviewRules(ctx context.Context, teamName string, rules []domain.ReviewRule) error {
+	stored := make([]reviewRule, len(rules))
+	for i, r := range rules {
+		stored[i] = reviewRule{
+			Label:          r.Label,
+			Priority:       r.Priority.String(),
+			Size:           r.Size.String(),
+			RequiredSkills: r.RequiredSkills,
+			SLAMinutes:     int(r.SLA / time.Minute),
+			ReviewersCount: r.ReviewersCount,
+		}
+	}
+	data, err := json.Marshal(stored)
+	if err != nil {
+		return err
+	}
+
+	tag, err := s.pool.Exec(ctx, `UPDATE teams SET review_rules = $2 WHERE name = $1`, teamName, data)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrTeamNotFound
+	}
+	return nil
+}
+
+func (s *Storage) GetReviewRules(ctx context.Context, teamName string) ([]domain.ReviewRule, error) {
+	var data []byte
+	err := s.pool.QueryRow(ctx, `SELECT review_rules FROM teams WHERE name = $1`, teamName).Scan(&data)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrTeamNotFound
+		}
+		return nil, err
+	}
+
+	var stored []reviewRule
+	if err = json.Unmarshal(data, &stored); err != nil {
+		return nil, err
+	}
+	rules := make([]domain.ReviewRule, len(stored))
+	for i, r := range stored {
+		rules[i] = domain.ReviewRule{
+			Label:          r.Label,
+			Priority:       domain.Priority(r.Priority),
+			Size:           domain.SizeBucket(r.Size),
+			RequiredSkills: r.RequiredSkills,
+			SLA:            time.Duration(r.SLAMinutes) * time.Minute,
+			ReviewersCount: r.ReviewersCount,
+		}
+	}
+	return rules, nil
+}
@@ -3,11 +3,14 @@ package user
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log/slog"
 	"time"
 
 	"github.com/LeoUraltsev/PRReviewerService/internal/domain"
+	"github.com/jackc/pgerrcode"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -29,12 +32,13 @@ type user struct {
 	TeamName  string
 	IsActive  bool
 	CreatedAt time.Time
+	Version   int64
 }
 
 func (s *Storage) GetByID(ctx context.Context, userID string) (*domain.User, error) {
-	q := `select id, username, team_name, is_active, created_at from users where id = $1`
+	q := `select id, username, team_name, is_active, created_at, version from users where id = $1`
 	var u user
-	err := s.pool.QueryRow(ctx, q, userID).Scan(&u.ID, &u.Username, &u.TeamName, &u.IsActive, &u.CreatedAt)
+	err := s.pool.QueryRow(ctx, q, userID).Scan(&u.ID, &u.Username, &u.TeamName, &u.IsActive, &u.CreatedAt, &u.Version)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, domain.ErrUserNotFound
@@ -46,19 +50,39 @@ func (s *Storage) GetByID(ctx context.Context, userID string) (*domain.User, err
 
 }
 
-func (s *Storage) UpdateIsActive(ctx context.Context, userID string, active bool) (*domain.User, error) {
-	q := `update users set is_active = $1 where id = $2 RETURNING id, username, team_name, is_active, created_at`
+// UpdateIsActive меняет флаг активности пользователя с проверкой ожидаемой версии version (см. versionMatches).
+func (s *Storage) UpdateIsActive(ctx context.Context, userID string, version int64, active bool) (*domain.User, error) {
+	q := `update users set is_active = $1, version = version + 1 where id = $2 and ` + versionMatches(3) + `
+	RETURNING id, username, team_name, is_active, created_at, version`
 	var u user
-	err := s.pool.QueryRow(ctx, q, active, userID).Scan(&u.ID, &u.Username, &u.TeamName, &u.IsActive, &u.CreatedAt)
+	err := s.pool.QueryRow(ctx, q, active, userID, version).Scan(
+		&u.ID, &u.Username, &u.TeamName, &u.IsActive, &u.CreatedAt, &u.Version,
+	)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, domain.ErrUserNotFound
+			return nil, s.notUpdated(ctx, userID)
 		}
 		return nil, err
 	}
 	return toDomainUser(&u), nil
 }
 
+// versionMatches возвращает условие UPDATE пользователя по ожидаемой версии из параметра запроса
+// с номером param: 0 — без проверки. Версия сравнивается в том же UPDATE, поэтому из двух
+// параллельных изменений с одной версией применяется только одно.
+func versionMatches(param int) string {
+	return fmt.Sprintf("($%d::bigint = 0 OR version = $%d)", param, param)
+}
+
+// notUpdated объясняет, почему UPDATE с versionMatches не изменил пользователя: его нет
+// или его версия уже другая.
+func (s *Storage) notUpdated(ctx context.Context, userID string) error {
+	if err := s.CheckExists(ctx, userID); err != nil {
+		return err
+	}
+	return domain.ErrVersionMismatch
+}
+
 func (s *Storage) SaveUsers(ctx context.Context, users []*domain.User) error {
 	tx, err := s.pool.Begin(ctx)
 	if err != nil {
@@ -82,7 +106,7 @@ func (s *Storage) SaveUsers(ctx context.Context, users []*domain.User) error {
 }
 
 func (s *Storage) GetUsersByTeamName(ctx context.Context, teamName string) ([]*domain.User, error) {
-	q := `select id, username, team_name, is_active, created_at from users where team_name = $1`
+	q := `select id, username, team_name, is_active, created_at, version from users where team_name = $1`
 	rows, err := s.pool.Query(ctx, q, teamName)
 	if err != nil {
 		return nil, err
@@ -91,7 +115,7 @@ func (s *Storage) GetUsersByTeamName(ctx context.Context, teamName string) ([]*d
 	users := make([]*domain.User, 0)
 	for rows.Next() {
 		var u user
-		err = rows.Scan(&u.ID, &u.Username, &u.TeamName, &u.IsActive, &u.CreatedAt)
+		err = rows.Scan(&u.ID, &u.Username, &u.TeamName, &u.IsActive, &u.CreatedAt, &u.Version)
 		if err != nil {
 			return nil, err
 		}
@@ -113,8 +137,43 @@ func (s *Storage) CheckExists(ctx context.Context, userID string) error {
 	return nil
 }
 
+// openReviews — число открытых PR, где пользователь u назначен ревьювером. Нагрузка выводится из
+// статуса PR, поэтому merge или закрытие освобождает её в той же транзакции, что и смена статуса.
+const openReviews = `(SELECT count(*) FROM reviewers rv JOIN pull_requests p ON p.id = rv.pr_id
+	WHERE rv.user_id = u.id AND p.status = 'OPEN')`
+
+// hasCapacity — условие на пользователя u: открытых ревью меньше его лимита или лимита команды.
+const hasCapacity = openReviews + ` <
+	COALESCE(u.max_open_reviews, (SELECT t.max_open_reviews FROM teams t WHERE t.name = u.team_name))`
+
+// LockCapacity блокирует строки пользователей userIDs до конца транзакции tx и проверяет, что у каждого
+// открытых ревью меньше лимита; иначе возвращается ErrReviewerAtCapacity. Вызывается перед назначением
+// ревьюверов: параллельные назначения одного пользователя ждут друг друга на блокировке, и каждое видит
+// ревью, закоммиченные предыдущим, поэтому лимит, проверенный сервисом до транзакции, не превышается гонкой.
+func LockCapacity(ctx context.Context, tx pgx.Tx, userIDs []string) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	q := `SELECT id FROM users WHERE id = ANY($1) ORDER BY id FOR NO KEY UPDATE`
+	if _, err := tx.Exec(ctx, q, userIDs); err != nil {
+		return err
+	}
+
+	var atCapacity bool
+	q = `SELECT EXISTS (SELECT 1 FROM users u WHERE u.id = ANY($1) AND NOT (` + hasCapacity + `))`
+	if err := tx.QueryRow(ctx, q, userIDs).Scan(&atCapacity); err != nil {
+		return err
+	}
+	if atCapacity {
+		return domain.ErrReviewerAtCapacity
+	}
+	return nil
+}
+
 func (s *Storage) GetInactiveUsers(ctx context.Context, teamName string, limit int, excludeUsers []string) ([]*domain.User, error) {
-	q := `SELECT id, username, team_name, is_active, created_at from users where team_name = $1 and is_active = true and id != ALL($3) limit $2`
+	q := `SELECT id, username, team_name, is_active, created_at from users u
+	where team_name = $1 and is_active = true and id != ALL($3) and ` + hasCapacity + ` limit $2`
 
 	rows, err := s.pool.Query(ctx, q, teamName, limit, excludeUsers)
 	if err != nil {
@@ -133,11 +192,259 @@ func (s *Storage) GetInactiveUsers(ctx context.Context, teamName string, limit i
 	return users, nil
 }
 
+// GetActiveUsersByHandle ищет доступных пользователей по владельцу из CODEOWNERS:
+// handle сравнивается с id, username и логинами в форжах.
+func (s *Storage) GetActiveUsersByHandle(ctx context.Context, handle string, excludeUsers []string) ([]*domain.User, error) {
+	q := `SELECT DISTINCT u.id, u.username, u.team_name, u.is_active, u.created_at FROM users u
+	LEFT JOIN forge_accounts fa ON fa.user_id = u.id
+	WHERE (u.id = $1 OR u.username = $1 OR fa.login = $1) AND u.is_active = true AND u.id != ALL($2) AND ` + hasCapacity + `
+	ORDER BY u.id`
+
+	rows, err := s.pool.Query(ctx, q, handle, excludeUsers)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	users := make([]*domain.User, 0)
+	for rows.Next() {
+		var u user
+		err = rows.Scan(&u.ID, &u.Username, &u.TeamName, &u.IsActive, &u.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, toDomainUser(&u))
+	}
+	return users, rows.Err()
+}
+
 func toDomainUser(u *user) *domain.User {
 	return &domain.User{
 		UserID:   u.ID,
 		Username: u.Username,
 		TeamName: u.TeamName,
 		IsActive: u.IsActive,
+		Version:  u.Version,
+	}
+}
+
+// GetInactiveUsersByRepository — аналог GetInactiveUsers для пула репозитория:
+// участники команд-владельцев и явно добавленные ревьюверы.
+func (s *Storage) GetInactiveUsersByRepository(ctx context.Context, repositoryID string, limit int, excludeUsers []string) ([]*domain.User, error) {
+	q := `SELECT id, username, team_name, is_active, created_at FROM users u
+	WHERE is_active = true AND id != ALL($3) AND ` + hasCapacity + ` AND (
+	    team_name IN (SELECT team_name FROM repository_teams WHERE repository_id = $1)
+	    OR id IN (SELECT user_id FROM repository_reviewers WHERE repository_id = $1))
+	ORDER BY id LIMIT $2`
+
+	rows, err := s.pool.Query(ctx, q, repositoryID, limit, excludeUsers)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	users := make([]*domain.User, 0)
+	for rows.Next() {
+		var u user
+		err = rows.Scan(&u.ID, &u.Username, &u.TeamName, &u.IsActive, &u.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, toDomainUser(&u))
+	}
+	return users, rows.Err()
+}
+
+// SetSkills заменяет навыки пользователя с проверкой ожидаемой версии version (см. versionMatches)
+// и возвращает новую версию пользователя.
+func (s *Storage) SetSkills(ctx context.Context, userID string, version int64, skills []string) (int64, error) {
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(context.Background())
+
+	// Увеличение версии блокирует пользователя: параллельные замены навыков не перемешиваются.
+	q := `UPDATE users SET version = version + 1 WHERE id = $1 AND ` + versionMatches(2) + ` RETURNING version`
+	err = tx.QueryRow(ctx, q, userID, version).Scan(&version)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, s.notUpdated(ctx, userID)
+		}
+		return 0, err
+	}
+
+	if _, err = tx.Exec(ctx, `DELETE FROM user_skills WHERE user_id = $1`, userID); err != nil {
+		return 0, err
+	}
+	q = `INSERT INTO user_skills (user_id, skill) SELECT $1, unnest($2::text[])`
+	if _, err = tx.Exec(ctx, q, userID, skills); err != nil {
+		return 0, err
+	}
+	return version, tx.Commit(ctx)
+}
+
+// GetSkills возвращает навыки пользователей userIDs; пользователи без навыков в результат не попадают.
+func (s *Storage) GetSkills(ctx context.Context, userIDs []string) (map[string][]string, error) {
+	q := `SELECT user_id, array_agg(skill ORDER BY skill) FROM user_skills WHERE user_id = ANY($1) GROUP BY user_id`
+	rows, err := s.pool.Query(ctx, q, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	skills := make(map[string][]string, len(userIDs))
+	for rows.Next() {
+		var userID string
+		var userSkills []string
+		if err = rows.Scan(&userID, &userSkills); err != nil {
+			return nil, err
+		}
+		skills[userID] = userSkills
+	}
+	return skills, rows.Err()
+}
+
+// SetMaxOpenReviews задаёт пользователю лимит открытых ревью; nil возвращает лимит команды.
+// Ожидаемая версия проверяется так же, как в UpdateIsActive.
+func (s *Storage) SetMaxOpenReviews(ctx context.Context, userID string, version int64, limit *int) error {
+	q := `UPDATE users SET max_open_reviews = $2, version = version + 1 WHERE id = $1 AND ` + versionMatches(3)
+	tag, err := s.pool.Exec(ctx, q, userID, limit, version)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return s.notUpdated(ctx, userID)
+	}
+	return nil
+}
+
+const selectCapacity = `SELECT u.id, u.username, u.is_active, ` + openReviews + `,
+    COALESCE(u.max_open_reviews, t.max_open_reviews), u.max_open_reviews IS NOT NULL, u.version
+FROM users u JOIN teams t ON t.name = u.team_name`
+
+func (s *Storage) GetCapacity(ctx context.Context, userID string) (*domain.ReviewerCapacity, error) {
+	var c domain.ReviewerCapacity
+	err := s.pool.QueryRow(ctx, selectCapacity+` WHERE u.id = $1`, userID).Scan(
+		&c.UserID, &c.Username, &c.IsActive, &c.OpenReviews, &c.MaxOpenReviews, &c.CustomLimit, &c.Version,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrUserNotFound
+		}
+		return nil, err
+	}
+	return &c, nil
+}
+
+// GetCapacitiesByTeamName возвращает загрузку всех участников команды.
+func (s *Storage) GetCapacitiesByTeamName(ctx context.Context, teamName string) ([]*domain.ReviewerCapacity, error) {
+	rows, err := s.pool.Query(ctx, selectCapacity+` WHERE u.team_name = $1 ORDER BY u.id`, teamName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := make([]*domain.ReviewerCapacity, 0)
+	for rows.Next() {
+		var c domain.ReviewerCapacity
+		err = rows.Scan(&c.UserID, &c.Username, &c.IsActive, &c.OpenReviews, &c.MaxOpenReviews, &c.CustomLimit, &c.Version)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, &c)
+	}
+	return res, rows.Err()
+}
+
+// GetOutOfOfficeUserIDs возвращает пользователей, у которых на момент at идёт период отсутствия.
+func (s *Storage) GetOutOfOfficeUserIDs(ctx context.Context, at time.Time) ([]string, error) {
+	q := `SELECT DISTINCT user_id FROM out_of_office WHERE starts_at <= $1 AND ends_at > $1`
+	rows, err := s.pool.Query(ctx, q, at.UTC())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ids := make([]string, 0)
+	for rows.Next() {
+		var id string
+		if err = rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+// SetWorkingHours сохраняет или заменяет рабочее расписание пользователя.
+func (s *Storage) SetWorkingHours(ctx context.Context, hours *domain.WorkingHours) error {
+	days := make([]int16, len(hours.Days))
+	for i, d := range hours.Days {
+		days[i] = int16(d)
+	}
+
+	q := `
+	INSERT INTO working_hours (user_id, time_zone, days, start_minute, end_minute)
+	VALUES ($1, $2, $3, $4, $5)
+	ON CONFLICT (user_id) DO UPDATE SET
+	    time_zone = excluded.time_zone,
+	    days = excluded.days,
+	    start_minute = excluded.start_minute,
+	    end_minute = excluded.end_minute,
+	    updated_at = timezone('utc', now())
+	`
+	_, err := s.pool.Exec(ctx, q, hours.UserID, hours.TimeZone, days, hours.Start, hours.End)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.ForeignKeyViolation {
+			return domain.ErrUserNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// GetWorkingHours возвращает расписания пользователей userIDs вместе с политикой их команд;
+// пользователи без расписания в результат не попадают.
+func (s *Storage) GetWorkingHours(ctx context.Context, userIDs []string) (map[string]*domain.WorkingHours, error) {
+	q := `
+	SELECT wh.user_id, wh.time_zone, wh.days, wh.start_minute, wh.end_minute, t.working_hours_policy
+	FROM working_hours wh
+	JOIN users u ON u.id = wh.user_id
+	JOIN teams t ON t.name = u.team_name
+	WHERE wh.user_id = ANY($1)
+	`
+	rows, err := s.pool.Query(ctx, q, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := make(map[string]*domain.WorkingHours, len(userIDs))
+	for rows.Next() {
+		var (
+			hours  domain.WorkingHours
+			days   []int16
+			policy string
+		)
+		if err = rows.Scan(&hours.UserID, &hours.TimeZone, &days, &hours.Start, &hours.End, &policy); err != nil {
+			return nil, err
+		}
+		for _, d := range days {
+			hours.Days = append(hours.Days, time.Weekday(d))
+		}
+		hours.Policy = domain.WorkingHoursPolicy(policy)
+		res[hours.UserID] = &hours
 	}
+	return res, rows.Err()
+}
+
+func (s *Storage) DeleteWorkingHours(ctx context.Context, userID string) error {
+	tag, err := s.pool.Exec(ctx, `DELETE FROM working_hours WHERE user_id = $1`, userID)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrWorkingHoursNotFound
+	}
+	return nil
 }
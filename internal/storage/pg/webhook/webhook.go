@@ -0,0 +1,185 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/LeoUraltsev/PRReviewerService/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type Storage struct {
+	log  *slog.Logger
+	pool *pgxpool.Pool
+}
+
+func NewStorage(log *slog.Logger, pool *pgxpool.Pool) *Storage {
+	return &Storage{
+		log:  log,
+		pool: pool,
+	}
+}
+
+type subscription struct {
+	ID        string
+	URL       string
+	Secret    string
+	Events    []string
+	CreatedAt time.Time
+}
+
+type delivery struct {
+	ID             int64
+	SubscriptionID string
+	EventID        string
+	EventType      string
+	Attempt        int
+	StatusCode     *int
+	Error          *string
+	Success        bool
+	CreatedAt      time.Time
+}
+
+func (s *Storage) SaveSubscription(ctx context.Context, sub *domain.WebhookSubscription) (*domain.WebhookSubscription, error) {
+	q := `INSERT INTO webhook_subscriptions (id, url, secret, events) VALUES ($1, $2, $3, $4)
+	RETURNING id, url, secret, events, created_at`
+
+	events := make([]string, len(sub.Events))
+	for i, e := range sub.Events {
+		events[i] = e.String()
+	}
+
+	var row subscription
+	err := s.pool.QueryRow(ctx, q, sub.ID, sub.URL, sub.Secret, events).
+		Scan(&row.ID, &row.URL, &row.Secret, &row.Events, &row.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return toDomainSubscription(&row), nil
+}
+
+func (s *Storage) DeleteSubscription(ctx context.Context, id string) error {
+	q := `DELETE FROM webhook_subscriptions WHERE id = $1`
+	tag, err := s.pool.Exec(ctx, q, id)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrWebhookNotFound
+	}
+	return nil
+}
+
+func (s *Storage) GetSubscription(ctx context.Context, id string) (*domain.WebhookSubscription, error) {
+	q := `SELECT id, url, secret, events, created_at FROM webhook_subscriptions WHERE id = $1`
+	var row subscription
+	err := s.pool.QueryRow(ctx, q, id).Scan(&row.ID, &row.URL, &row.Secret, &row.Events, &row.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrWebhookNotFound
+		}
+		return nil, err
+	}
+	return toDomainSubscription(&row), nil
+}
+
+func (s *Storage) GetSubscriptionsByEvent(ctx context.Context, eventType domain.EventType) ([]*domain.WebhookSubscription, error) {
+	q := `SELECT id, url, secret, events, created_at FROM webhook_subscriptions WHERE $1 = ANY(events)`
+	rows, err := s.pool.Query(ctx, q, eventType.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	subs := make([]*domain.WebhookSubscription, 0)
+	for rows.Next() {
+		var row subscription
+		err = rows.Scan(&row.ID, &row.URL, &row.Secret, &row.Events, &row.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		subs = append(subs, toDomainSubscription(&row))
+	}
+	return subs, rows.Err()
+}
+
+func (s *Storage) SaveDelivery(ctx context.Context, d *domain.WebhookDelivery) error {
+	q := `INSERT INTO webhook_deliveries (subscription_id, event_id, event_type, attempt, status_code, error, success)
+	VALUES ($1, $2, $3, $4, $5, $6, $7)`
+	_, err := s.pool.Exec(ctx, q, d.SubscriptionID, d.EventID, d.EventType.String(), d.Attempt, d.StatusCode, d.Error, d.Success)
+	return err
+}
+
+func (s *Storage) GetDeliveries(ctx context.Context, subscriptionID string, limit int) ([]*domain.WebhookDelivery, error) {
+	q := `SELECT id, subscription_id, event_id, event_type, attempt, status_code, error, success, created_at
+	FROM webhook_deliveries WHERE subscription_id = $1 ORDER BY id DESC LIMIT $2`
+	rows, err := s.pool.Query(ctx, q, subscriptionID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	deliveries := make([]*domain.WebhookDelivery, 0)
+	for rows.Next() {
+		var d delivery
+		err = rows.Scan(&d.ID, &d.SubscriptionID, &d.EventID, &d.EventType, &d.Attempt, &d.StatusCode, &d.Error, &d.Success, &d.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, toDomainDelivery(&d))
+	}
+	return deliveries, rows.Err()
+}
+
+// GetDeliveriesByEvent возвращает все попытки доставки события eventID по порядку.
+func (s *Storage) GetDeliveriesByEvent(ctx context.Context, eventID string) ([]*domain.WebhookDelivery, error) {
+	q := `SELECT id, subscription_id, event_id, event_type, attempt, status_code, error, success, created_at
+	FROM webhook_deliveries WHERE event_id = $1 ORDER BY id`
+	rows, err := s.pool.Query(ctx, q, eventID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	deliveries := make([]*domain.WebhookDelivery, 0)
+	for rows.Next() {
+		var d delivery
+		err = rows.Scan(&d.ID, &d.SubscriptionID, &d.EventID, &d.EventType, &d.Attempt, &d.StatusCode, &d.Error, &d.Success, &d.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, toDomainDelivery(&d))
+	}
+	return deliveries, rows.Err()
+}
+
+func toDomainSubscription(s *subscription) *domain.WebhookSubscription {
+	events := make([]domain.EventType, len(s.Events))
+	for i, e := range s.Events {
+		events[i] = domain.EventType(e)
+	}
+	return &domain.WebhookSubscription{
+		ID:        s.ID,
+		URL:       s.URL,
+		Secret:    s.Secret,
+		Events:    events,
+		CreatedAt: s.CreatedAt,
+	}
+}
+
+func toDomainDelivery(d *delivery) *domain.WebhookDelivery {
+	return &domain.WebhookDelivery{
+		ID:             d.ID,
+		SubscriptionID: d.SubscriptionID,
+		EventID:        d.EventID,
+		EventType:      domain.EventType(d.EventType),
+		Attempt:        d.Attempt,
+		StatusCode:     d.StatusCode,
+		Error:          d.Error,
+		Success:        d.Success,
+		CreatedAt:      d.CreatedAt,
+	}
+}
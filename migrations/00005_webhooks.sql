@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS webhook_subscriptions (
+    id text primary key,
+    url text not null,
+    secret text not null,
+    events text[] not null,
+    created_at timestamp default (timezone('utc', now()))
+);
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    id bigserial primary key,
+    subscription_id text not null references webhook_subscriptions(id) on delete cascade,
+    event_id text not null,
+    event_type text not null,
+    attempt int not null,
+    status_code int,
+    error text,
+    success boolean not null,
+    created_at timestamp default (timezone('utc', now()))
+);
+CREATE INDEX idx_webhook_deliveries_subscription ON webhook_deliveries (subscription_id, created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE if exists webhook_deliveries;
+DROP TABLE if exists webhook_subscriptions;
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS outbox (
+    id bigserial primary key,
+    event_id text unique not null,
+    event_type text not null,
+    aggregate_id text not null,
+    payload jsonb not null,
+    attempts int not null default 0,
+    last_error text,
+    next_attempt_at timestamp not null default (timezone('utc', now())),
+    created_at timestamp default (timezone('utc', now())),
+    published_at timestamp,
+    failed_at timestamp
+);
+CREATE INDEX idx_outbox_pending ON outbox (aggregate_id, id) WHERE published_at IS NULL AND failed_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE if exists outbox;
+-- +goose StatementEnd
@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS forge_accounts (
+    provider text not null,
+    login text not null,
+    user_id text not null references users(id) on delete cascade,
+    created_at timestamp default (timezone('utc', now())),
+    PRIMARY KEY (provider, login)
+);
+
+CREATE TABLE IF NOT EXISTS forge_repositories (
+    provider text not null,
+    full_name text not null,
+    created_at timestamp not null default (timezone('utc', now())),
+    PRIMARY KEY (provider, full_name)
+);
+
+CREATE TABLE IF NOT EXISTS forge_pull_requests (
+    provider text not null,
+    repository text not null,
+    number bigint not null,
+    pr_id text not null unique references pull_requests(id) on delete cascade,
+    PRIMARY KEY (provider, repository, number)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE if exists forge_pull_requests;
+DROP TABLE if exists forge_repositories;
+DROP TABLE if exists forge_accounts;
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE pull_requests ADD IF NOT EXISTS repository_id text;
+ALTER TABLE pull_requests ADD IF NOT EXISTS changed_files text[] not null default '{}';
+
+CREATE TABLE IF NOT EXISTS codeowners (
+    repository_id text primary key,
+    content text not null,
+    updated_at timestamp not null default (timezone('utc', now()))
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE if exists codeowners;
+ALTER TABLE pull_requests DROP IF EXISTS changed_files;
+ALTER TABLE pull_requests DROP IF EXISTS repository_id;
+-- +goose StatementEnd
@@ -0,0 +1,46 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS repositories (
+    id text primary key,
+    name text not null,
+    reviewers_count int not null default 2,
+    author_team_fallback boolean not null default true,
+    created_at timestamp not null default (timezone('utc', now())),
+    updated_at timestamp not null default (timezone('utc', now()))
+);
+
+CREATE TABLE IF NOT EXISTS repository_teams (
+    repository_id text not null references repositories(id) on delete cascade,
+    team_name text not null references teams(name) on delete cascade,
+    PRIMARY KEY (repository_id, team_name)
+);
+
+CREATE TABLE IF NOT EXISTS repository_reviewers (
+    repository_id text not null references repositories(id) on delete cascade,
+    user_id text not null references users(id) on delete cascade,
+    PRIMARY KEY (repository_id, user_id)
+);
+
+-- Репозитории, на которые уже ссылаются PR и CODEOWNERS, заводятся без пула ревьюверов.
+INSERT INTO repositories (id, name)
+SELECT repository_id, repository_id FROM pull_requests WHERE repository_id IS NOT NULL
+UNION
+SELECT repository_id, repository_id FROM codeowners
+ON CONFLICT (id) DO NOTHING;
+
+ALTER TABLE pull_requests ADD CONSTRAINT pull_requests_repository_id_fkey
+    FOREIGN KEY (repository_id) REFERENCES repositories(id) ON DELETE SET NULL;
+CREATE INDEX IF NOT EXISTS idx_pull_requests_repository_id ON pull_requests (repository_id);
+ALTER TABLE codeowners ADD CONSTRAINT codeowners_repository_id_fkey
+    FOREIGN KEY (repository_id) REFERENCES repositories(id) ON DELETE CASCADE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE codeowners DROP CONSTRAINT IF EXISTS codeowners_repository_id_fkey;
+DROP INDEX IF EXISTS idx_pull_requests_repository_id;
+ALTER TABLE pull_requests DROP CONSTRAINT IF EXISTS pull_requests_repository_id_fkey;
+DROP TABLE if exists repository_reviewers;
+DROP TABLE if exists repository_teams;
+DROP TABLE if exists repositories;
+-- +goose StatementEnd
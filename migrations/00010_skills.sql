@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS user_skills (
+    user_id text not null references users(id) on delete cascade,
+    skill text not null,
+    PRIMARY KEY (user_id, skill)
+);
+CREATE INDEX IF NOT EXISTS idx_user_skills_skill ON user_skills (skill);
+
+ALTER TABLE pull_requests ADD IF NOT EXISTS required_skills text[] not null default '{}';
+ALTER TABLE pull_requests ADD IF NOT EXISTS missing_skills text[] not null default '{}';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE pull_requests DROP IF EXISTS missing_skills;
+ALTER TABLE pull_requests DROP IF EXISTS required_skills;
+DROP TABLE if exists user_skills;
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE teams ADD IF NOT EXISTS max_open_reviews int not null default 3;
+ALTER TABLE users ADD IF NOT EXISTS max_open_reviews int;
+CREATE INDEX IF NOT EXISTS idx_reviewers_user_id ON reviewers (user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_reviewers_user_id;
+ALTER TABLE users DROP IF EXISTS max_open_reviews;
+ALTER TABLE teams DROP IF EXISTS max_open_reviews;
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS out_of_office (
+    id bigserial primary key,
+    user_id text not null references users(id) on delete cascade,
+    starts_at timestamp not null,
+    ends_at timestamp not null,
+    reason text not null default '',
+    reassign_reviews boolean not null default false,
+    reassigned_at timestamp,
+    created_at timestamp not null default (timezone('utc', now())),
+    CHECK (ends_at > starts_at)
+);
+CREATE INDEX IF NOT EXISTS idx_out_of_office_user_id ON out_of_office (user_id, ends_at);
+CREATE INDEX IF NOT EXISTS idx_out_of_office_pending ON out_of_office (starts_at)
+    WHERE reassign_reviews AND reassigned_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE if exists out_of_office;
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS working_hours (
+    user_id text primary key references users(id) on delete cascade,
+    time_zone text not null,
+    days smallint[] not null,
+    start_minute smallint not null check (start_minute >= 0 AND start_minute < 1440),
+    end_minute smallint not null check (end_minute >= 0 AND end_minute < 1440),
+    updated_at timestamp not null default (timezone('utc', now()))
+);
+
+ALTER TABLE teams ADD IF NOT EXISTS working_hours_policy text not null default 'prefer'
+    check (working_hours_policy IN ('prefer', 'require'));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE teams DROP IF EXISTS working_hours_policy;
+DROP TABLE if exists working_hours;
+-- +goose StatementEnd
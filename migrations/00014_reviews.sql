@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE reviewers ADD IF NOT EXISTS verdict text not null default 'PENDING'
+    check (verdict IN ('PENDING', 'APPROVED', 'CHANGES_REQUESTED', 'COMMENTED'));
+ALTER TABLE reviewers ADD IF NOT EXISTS comment text not null default '';
+ALTER TABLE reviewers ADD IF NOT EXISTS assigned_at timestamp not null default (timezone('utc', now()));
+ALTER TABLE reviewers ADD IF NOT EXISTS submitted_at timestamp;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE reviewers DROP IF EXISTS submitted_at;
+ALTER TABLE reviewers DROP IF EXISTS assigned_at;
+ALTER TABLE reviewers DROP IF EXISTS comment;
+ALTER TABLE reviewers DROP IF EXISTS verdict;
+-- +goose StatementEnd
@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE teams ADD IF NOT EXISTS merge_required_approvals int not null default 0;
+ALTER TABLE teams ADD IF NOT EXISTS merge_block_on_changes_requested boolean not null default false;
+ALTER TABLE teams ADD IF NOT EXISTS merge_require_full_staff boolean not null default false;
+
+ALTER TABLE repositories ADD IF NOT EXISTS merge_required_approvals int not null default 0;
+ALTER TABLE repositories ADD IF NOT EXISTS merge_block_on_changes_requested boolean not null default false;
+ALTER TABLE repositories ADD IF NOT EXISTS merge_require_full_staff boolean not null default false;
+
+CREATE TABLE IF NOT EXISTS audit_log (
+    id bigserial primary key,
+    pr_id text not null references pull_requests(id) on delete cascade,
+    action text not null,
+    actor text not null,
+    reason text not null,
+    details jsonb not null default '[]',
+    created_at timestamp not null default (timezone('utc', now()))
+);
+CREATE INDEX IF NOT EXISTS idx_audit_log_pr_id ON audit_log (pr_id, id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE if exists audit_log;
+ALTER TABLE repositories DROP IF EXISTS merge_require_full_staff;
+ALTER TABLE repositories DROP IF EXISTS merge_block_on_changes_requested;
+ALTER TABLE repositories DROP IF EXISTS merge_required_approvals;
+ALTER TABLE teams DROP IF EXISTS merge_require_full_staff;
+ALTER TABLE teams DROP IF EXISTS merge_block_on_changes_requested;
+ALTER TABLE teams DROP IF EXISTS merge_required_approvals;
+-- +goose StatementEnd
@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TYPE status_enum ADD VALUE IF NOT EXISTS 'DRAFT';
+ALTER TYPE status_enum ADD VALUE IF NOT EXISTS 'CLOSED';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+UPDATE pull_requests SET status = 'OPEN' WHERE status IN ('DRAFT', 'CLOSED');
+ALTER TYPE status_enum RENAME TO status_enum_old;
+CREATE TYPE status_enum AS ENUM ('MERGED','OPEN');
+ALTER TABLE pull_requests ALTER COLUMN status TYPE status_enum USING status::text::status_enum;
+DROP TYPE status_enum_old;
+-- +goose StatementEnd
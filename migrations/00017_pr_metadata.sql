@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE pull_requests ADD IF NOT EXISTS description text not null default '';
+ALTER TABLE pull_requests ADD IF NOT EXISTS labels text[] not null default '{}';
+ALTER TABLE pull_requests ADD IF NOT EXISTS version bigint not null default 1;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE pull_requests DROP IF EXISTS version;
+ALTER TABLE pull_requests DROP IF EXISTS labels;
+ALTER TABLE pull_requests DROP IF EXISTS description;
+-- +goose StatementEnd
@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE pull_requests ADD IF NOT EXISTS priority text not null default 'NORMAL';
+ALTER TABLE pull_requests ADD IF NOT EXISTS review_due_at timestamp;
+
+ALTER TABLE teams ADD IF NOT EXISTS review_rules jsonb not null default '[]';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE teams DROP IF EXISTS review_rules;
+ALTER TABLE pull_requests DROP IF EXISTS review_due_at;
+ALTER TABLE pull_requests DROP IF EXISTS priority;
+-- +goose StatementEnd
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE pull_requests ADD IF NOT EXISTS lines_added int;
+ALTER TABLE pull_requests ADD IF NOT EXISTS lines_removed int;
+ALTER TABLE pull_requests ADD IF NOT EXISTS files_changed int;
+ALTER TABLE pull_requests ADD IF NOT EXISTS reviewers_count int not null default 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE pull_requests DROP IF EXISTS reviewers_count;
+ALTER TABLE pull_requests DROP IF EXISTS files_changed;
+ALTER TABLE pull_requests DROP IF EXISTS lines_removed;
+ALTER TABLE pull_requests DROP IF EXISTS lines_added;
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Раньше назначение ревьювером выставляло is_active = false, и ревьювер оставался недоступным навсегда.
+-- Нагрузка теперь считается по открытым PR, но по данным такую деактивацию не отличить от ручной:
+-- старый путь не оставлял записи, а версия пользователей появилась только в 00018. Поэтому в активные
+-- возвращаются только ревьюверы, явно перечисленные через запятую в параметре pr_reviewer.restore_user_ids
+-- (например, options=-c pr_reviewer.restore_user_ids=u1,u2 в строке подключения goose).
+-- Без параметра миграция ничего не меняет.
+UPDATE users u
+SET is_active = true, version = version + 1
+WHERE u.is_active = false
+  AND u.id = ANY (regexp_split_to_array(
+      NULLIF(btrim(current_setting('pr_reviewer.restore_user_ids', true)), ''), '\s*,\s*'))
+  AND EXISTS (SELECT 1 FROM reviewers rv WHERE rv.user_id = u.id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- Пользователей, деактивированных старым назначением, восстановить нельзя: откатывать нечего.
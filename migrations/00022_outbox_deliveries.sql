@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Relay арендует события до leased_until и доставляет их вне транзакции; outbox_deliveries хранит,
+-- какие получатели уже получили событие, чтобы при повторе оно отправлялось только остальным.
+ALTER TABLE outbox ADD COLUMN leased_until timestamp;
+CREATE TABLE IF NOT EXISTS outbox_deliveries (
+    outbox_id bigint not null references outbox (id) on delete cascade,
+    sink text not null,
+    delivered_at timestamp not null default (timezone('utc', now())),
+    primary key (outbox_id, sink)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS outbox_deliveries;
+ALTER TABLE outbox DROP COLUMN IF EXISTS leased_until;
+-- +goose StatementEnd
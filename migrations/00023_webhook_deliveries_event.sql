@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Dispatcher перед повтором события ищет по журналу подписчиков, которые уже получили его.
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_event ON webhook_deliveries (event_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_webhook_deliveries_event;
+-- +goose StatementEnd